@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the request used to associate and disassociate
+// existing resources to a collection, for example hosts to a group.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type AssociationPostRequest struct {
+	Request
+
+	input data.AssociationPostRequest
+}
+
+func newAssociationPostRequest(resource *Resource, id int, disassociate bool) *AssociationPostRequest {
+	request := new(AssociationPostRequest)
+	request.resource = resource
+	request.input.Id = id
+	request.input.Disassociate = disassociate
+	return request
+}
+
+func (r *AssociationPostRequest) Send() (response *AssociationPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *AssociationPostRequest) SendContext(ctx context.Context) (response *AssociationPostResponse, err error) {
+	err = r.post(ctx, &r.input, nil)
+	if err != nil {
+		return
+	}
+	response = new(AssociationPostResponse)
+	return
+}
+
+type AssociationPostResponse struct {
+}
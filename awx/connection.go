@@ -18,18 +18,20 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
-	"github.com/moolitayer/awx-client-go/awx/internal/data"
 )
 
 // Version is the version of the client.
@@ -46,24 +48,45 @@ type ConnectionBuilder struct {
 	bearer   string
 	insecure bool
 
+	// Custom provider of credentials, alternative to the user name, token and bearer:
+	credentials CredentialsProvider
+
+	// OAuth2 application used to exchange the user name and password for tokens:
+	clientId     string
+	clientSecret string
+
 	// Trusted CA certificates can be loaded from slices of bytes or from files:
 	caCerts [][]byte
 	caFiles []string
+
+	// The client certificate and key can also be loaded from slices of bytes or from files:
+	clientCert     []byte
+	clientKey      []byte
+	clientCertFile string
+	clientKeyFile  string
+
+	// TLS settings that override the defaults:
+	minTLSVersion uint16
+	serverName    string
+
+	// The policy for retrying failed requests:
+	retry *RetryPolicy
 }
 
 type Connection struct {
 	// Basic data:
-	base     string
-	username string
-	password string
-	agent    string
-	version  string
-	// AWX had two implementations for authentication tokens
-	token  string // using the /authtoken endpoint, used in tower < 3.3
-	bearer string // an OAuth2 implementation, used since tower 3.3
+	base    string
+	agent   string
+	version string
+
+	// The provider of the credentials sent with each request:
+	credentials CredentialsProvider
 
 	// The underlying HTTP client:
 	client *http.Client
+
+	// The policy for retrying failed requests, with the defaults already applied:
+	retry *RetryPolicy
 }
 
 func NewConnectionBuilder() *ConnectionBuilder {
@@ -115,11 +138,42 @@ func (b *ConnectionBuilder) Bearer(bearer string) *ConnectionBuilder {
 	return b
 }
 
+// Credentials sets the provider that the connection will use to obtain the credentials that it
+// sends with each request. This is an alternative to the Username, Token and Bearer methods, which
+// are equivalent to using the providers returned by the PasswordCredentials, TokenCredentials and
+// BearerCredentials functions.
+//
+func (b *ConnectionBuilder) Credentials(provider CredentialsProvider) *ConnectionBuilder {
+	b.credentials = provider
+	return b
+}
+
+// OAuth2Client sets the identifier and secret of the OAuth2 application that the connection will use
+// to exchange the user name and password for access and refresh tokens, using the '/api/o/token'
+// endpoint, instead of creating personal access tokens. The secret can be empty for public
+// applications. See the OAuth2Credentials function for details.
+//
+func (b *ConnectionBuilder) OAuth2Client(clientId, clientSecret string) *ConnectionBuilder {
+	b.clientId = clientId
+	b.clientSecret = clientSecret
+	return b
+}
+
 func (b *ConnectionBuilder) Insecure(insecure bool) *ConnectionBuilder {
 	b.insecure = insecure
 	return b
 }
 
+// Retry sets the policy used to retry requests that fail because of network errors or because the
+// server responds with status codes that indicate transient problems. This is optional, and by
+// default GET and HEAD requests are retried up to three times. See the RetryPolicy type for
+// details.
+//
+func (b *ConnectionBuilder) Retry(policy *RetryPolicy) *ConnectionBuilder {
+	b.retry = policy
+	return b
+}
+
 // CACertificates adds a list of CA certificates that will be trusted when verifying the
 // certificates presented by the AWX server. The certs parameter must be a list of PEM encoded
 // certificates.
@@ -142,6 +196,42 @@ func (b *ConnectionBuilder) CAFile(file string) *ConnectionBuilder {
 	return b
 }
 
+// ClientCertificate sets the PEM encoded certificate and key that the client will present to the
+// server when it requests a client certificate, for example when the server is behind a proxy that
+// requires mutual TLS authentication.
+//
+func (b *ConnectionBuilder) ClientCertificate(cert, key []byte) *ConnectionBuilder {
+	b.clientCert = cert
+	b.clientKey = key
+	return b
+}
+
+// ClientCertificateFiles sets the names of the files that contain the PEM encoded certificate and
+// key that the client will present to the server when it requests a client certificate.
+//
+func (b *ConnectionBuilder) ClientCertificateFiles(certFile, keyFile string) *ConnectionBuilder {
+	b.clientCertFile = certFile
+	b.clientKeyFile = keyFile
+	return b
+}
+
+// MinTLSVersion sets the minimum version of TLS that the client will accept, for example
+// tls.VersionTLS12. The default is the minimum version supported by the Go TLS library.
+//
+func (b *ConnectionBuilder) MinTLSVersion(version uint16) *ConnectionBuilder {
+	b.minTLSVersion = version
+	return b
+}
+
+// ServerName sets the name of the server that the client will send in the TLS handshake and use
+// to verify the certificate presented by the server. The default is the host name of the URL. This
+// is useful when the server is accessed using an address that doesn't match its certificate.
+//
+func (b *ConnectionBuilder) ServerName(name string) *ConnectionBuilder {
+	b.serverName = name
+	return b
+}
+
 func (b *ConnectionBuilder) Build() (c *Connection, err error) {
 	// Check the URL:
 	if b.url == "" {
@@ -170,10 +260,30 @@ func (b *ConnectionBuilder) Build() (c *Connection, err error) {
 			authArgs++
 		}
 	}
+	if b.credentials != nil {
+		authArgs++
+	}
 	if authArgs != 1 {
-		err = fmt.Errorf("Exactly one of the following is required: username, token or bearer")
+		err = fmt.Errorf(
+			"Exactly one of the following is required: username, token, bearer or credentials",
+		)
 		return
 	}
+	if b.clientId != "" && b.username == "" {
+		err = fmt.Errorf("The OAuth2 client requires the user name and password")
+		return
+	}
+	credentials := b.credentials
+	switch {
+	case b.username != "" && b.clientId != "":
+		credentials = OAuth2Credentials(b.clientId, b.clientSecret, b.username, b.password)
+	case b.username != "":
+		credentials = PasswordCredentials(b.username, b.password)
+	case b.token != "":
+		credentials = TokenCredentials(b.token)
+	case b.bearer != "":
+		credentials = BearerCredentials(b.bearer)
+	}
 
 	// Check the security flags:
 	if len(b.caCerts)+len(b.caFiles) > 0 && b.insecure {
@@ -230,12 +340,45 @@ func (b *ConnectionBuilder) Build() (c *Connection, err error) {
 		}
 	}
 
+	// Load the client certificate:
+	if len(b.clientCert) > 0 && b.clientCertFile != "" {
+		err = fmt.Errorf("Client certificate and client certificate files are mutually exclusive")
+		return
+	}
+	var clientCerts []tls.Certificate
+	if len(b.clientCert) > 0 {
+		var clientCert tls.Certificate
+		clientCert, err = tls.X509KeyPair(b.clientCert, b.clientKey)
+		if err != nil {
+			err = fmt.Errorf("Can't load client certificate: %s", err)
+			return
+		}
+		clientCerts = append(clientCerts, clientCert)
+	}
+	if b.clientCertFile != "" {
+		var clientCert tls.Certificate
+		clientCert, err = tls.LoadX509KeyPair(b.clientCertFile, b.clientKeyFile)
+		if err != nil {
+			err = fmt.Errorf(
+				"Can't load client certificate file '%s' and key file '%s': %s",
+				b.clientCertFile,
+				b.clientKeyFile,
+				err,
+			)
+			return
+		}
+		clientCerts = append(clientCerts, clientCert)
+	}
+
 	// Create the HTTP client:
 	client := &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
 				InsecureSkipVerify: b.insecure,
 				RootCAs:            certStore,
+				Certificates:       clientCerts,
+				MinVersion:         b.minTLSVersion,
+				ServerName:         b.serverName,
 			},
 			Proxy: func(request *http.Request) (result *url.URL, err error) {
 				result = proxy
@@ -247,10 +390,13 @@ func (b *ConnectionBuilder) Build() (c *Connection, err error) {
 	// Allocate the connection and save all the objects that will be required later:
 	c = new(Connection)
 	c.base = b.url
-	c.username = b.username
-	c.password = b.password
+	c.credentials = credentials
 	c.version = "v2"
 	c.client = client
+	c.retry = newRetryPolicy(b.retry)
+	if binder, ok := credentials.(credentialsBinder); ok {
+		binder.bind(c)
+	}
 
 	// Ensure that the base URL has an slash at the end:
 	if !strings.HasSuffix(c.base, "/") {
@@ -260,6 +406,38 @@ func (b *ConnectionBuilder) Build() (c *Connection, err error) {
 	return
 }
 
+// Groups returns a reference to the resource that manages the collection of groups.
+//
+func (c *Connection) Groups() *GroupsResource {
+	return NewGroupsResource(c, "groups")
+}
+
+// Hosts returns a reference to the resource that manages the collection of hosts.
+//
+func (c *Connection) Hosts() *HostsResource {
+	return NewHostsResource(c, "hosts")
+}
+
+// Inventories returns a reference to the resource that manages the collection of inventories.
+//
+func (c *Connection) Inventories() *InventoriesResource {
+	return NewInventoriesResource(c, "inventories")
+}
+
+// InventorySources returns a reference to the resource that manages the collection of inventory
+// sources.
+//
+func (c *Connection) InventorySources() *InventorySourcesResource {
+	return NewInventorySourcesResource(c, "inventory_sources")
+}
+
+// InventoryUpdates returns a reference to the resource that manages the collection of inventory
+// updates.
+//
+func (c *Connection) InventoryUpdates() *InventoryUpdatesResource {
+	return NewInventoryUpdatesResource(c, "inventory_updates")
+}
+
 // Jobs returns a reference to the resource that manages the collection of jobs.
 //
 func (c *Connection) Jobs() *JobsResource {
@@ -272,88 +450,74 @@ func (c *Connection) JobTemplates() *JobTemplatesResource {
 	return NewJobTemplatesResource(c, "job_templates")
 }
 
+// ProjectUpdates returns a reference to the resource that manages the collection of project
+// updates.
+//
+func (c *Connection) ProjectUpdates() *ProjectUpdatesResource {
+	return NewProjectUpdatesResource(c, "project_updates")
+}
+
 // Projects returns a reference to the resource that manages the collection of projects.
 //
 func (c *Connection) Projects() *ProjectsResource {
 	return NewProjectsResource(c, "projects")
 }
 
-func (c *Connection) Close() {
-	c.token = ""
-}
-
-// ensureToken makes sure that there is a token available. If there isn't, then it will request a
-// new onw to the server.
+// Close releases the resources used by the connection. If the credentials provider implements the
+// io.Closer interface then it is closed too. For example, the tokens obtained using the user name
+// and password are revoked, so that they don't remain in the server. Tokens that were explicitly
+// given when building the connection aren't revoked. It is safe to call this method multiple
+// times.
 //
-func (c *Connection) ensureToken() error {
-	if c.token != "" || c.bearer != "" {
+func (c *Connection) Close() error {
+	closer, ok := c.credentials.(io.Closer)
+	if !ok {
 		return nil
 	}
-	return c.getToken()
+	return closer.Close()
 }
 
-// getToken requests a new authentication token.
+// authenticated obtains the credentials from the provider and then calls the given function, which
+// sends a request using the context that contains them. If the server rejects the credentials, for
+// example because a token has been revoked or expired sooner than expected, and the provider can
+// invalidate them, then it obtains new credentials and calls the function again, only once.
 //
-func (c *Connection) getToken() (err error) {
-	if c.OAuth2Supported() {
-		err = c.getPATToken()
-	} else {
-		err = c.getAuthToken()
-	}
+func (c *Connection) authenticated(ctx context.Context, send func(ctx context.Context) error) error {
+	authorization, err := c.credentials.Authorization(ctx)
 	if err != nil {
-		return
+		return err
 	}
-	return nil
-}
-
-func (c *Connection) OAuth2Supported() bool {
-	err := c.head("", "o")
-	if err != nil {
-		// Can fail due to other reasons(i.e network availability) and in that case
-		// the PAT request will also fail.
-		return false
+	err = send(withAuthorization(ctx, authorization))
+	if !IsUnauthorized(err) {
+		return err
+	}
+	invalidator, ok := c.credentials.(CredentialsInvalidator)
+	if !ok {
+		return err
 	}
-	return true
-}
-
-func (c *Connection) getAuthToken() error {
 	if glog.V(2) {
-		glog.Infoln("Requesting Authtoken")
+		glog.Infoln("Credentials rejected by the server, requesting new ones")
 	}
-	var request data.AuthTokenPostRequest
-	var response data.AuthTokenPostResponse
-	request.Username = c.username
-	request.Password = c.password
-	err := c.post("authtoken", nil, &request, &response)
+	invalidator.Invalidate(authorization)
+	authorization, err = c.credentials.Authorization(ctx)
 	if err != nil {
 		return err
 	}
-	if len(response.Token) == 0 {
-		return fmt.Errorf("Error obtaining auth token")
-	}
-	c.token = response.Token
-	return nil
+	return send(withAuthorization(ctx, authorization))
 }
 
-func (c *Connection) getPATToken() error {
-	if glog.V(2) {
-		glog.Infoln("Requesting OAuth2 PAT Token")
-	}
-	var request data.PATPostRequest
-	var response data.PATPostResponse
-	request.Description = "AWX Go Client"
-	request.Scope = "write"
-	err := c.post(
-		fmt.Sprintf("users/%s/personal_tokens", c.username),
-		nil,
-		&request,
-		&response,
-	)
+func (c *Connection) OAuth2Supported() bool {
+	return c.oauth2Supported(context.Background())
+}
+
+func (c *Connection) oauth2Supported(ctx context.Context) bool {
+	err := c.head(ctx, "", "o")
 	if err != nil {
-		return err
+		// Can fail due to other reasons(i.e network availability) and in that case
+		// the PAT request will also fail.
+		return false
 	}
-	c.bearer = response.Token
-	return nil
+	return true
 }
 
 // makeURL calculates the absolute URL for the given relative path and query.
@@ -384,191 +548,263 @@ func (c *Connection) makeURL(path, prefix string, query url.Values) string {
 	return buffer.String()
 }
 
-func (c *Connection) authenticatedGet(path string, query url.Values, output interface{}) error {
-	err := c.ensureToken()
-	if err != nil {
-		return err
-	}
-	return c.get(path, query, output)
+func (c *Connection) authenticatedGet(ctx context.Context, path string, query url.Values, output interface{}) error {
+	return c.authenticated(ctx, func(ctx context.Context) error {
+		return c.get(ctx, path, query, output)
+	})
 }
 
-func (c *Connection) get(path string, query url.Values, output interface{}) error {
-	outputBytes, err := c.rawGet(path, query)
+func (c *Connection) get(ctx context.Context, path string, query url.Values, output interface{}) error {
+	outputBytes, err := c.rawGet(ctx, path, query)
 	if err != nil {
 		return err
 	}
 	return json.Unmarshal(outputBytes, output)
 }
 
-func (c *Connection) head(path, prefix string) error {
-	if err := c.rawHead(path, prefix); err != nil {
+func (c *Connection) head(ctx context.Context, path, prefix string) error {
+	if err := c.rawHead(ctx, path, prefix); err != nil {
 		return err
 	}
 	return nil
 }
-func (c *Connection) rawHead(path, prefix string) (err error) {
+func (c *Connection) rawHead(ctx context.Context, path, prefix string) (err error) {
 	address := c.makeURL(path, prefix, nil)
-	request, err := http.NewRequest(http.MethodHead, address, nil)
-	if err != nil {
-		return
-	}
-	c.setAgent(request)
-	c.setCredentials(request)
-	c.setAccept(request)
-	if glog.V(2) {
-		glog.Infof("Sending HEAD request to '%s'.", address)
-		glog.Info("Request headers:\n")
-		for key, val := range request.Header {
-			glog.Infof("	%s: %v", key, val)
+	response, _, err := c.do(ctx, http.MethodHead, func() (request *http.Request, err error) {
+		request, err = http.NewRequestWithContext(ctx, http.MethodHead, address, nil)
+		if err != nil {
+			return
 		}
-	}
-	response, err := c.client.Do(request)
+		c.setAgent(request)
+		c.setCredentials(request)
+		c.setAccept(request, "application/json")
+		if glog.V(2) {
+			glog.Infof("Sending HEAD request to '%s'.", address)
+			glog.Info("Request headers:\n")
+			for key, val := range request.Header {
+				glog.Infof("	%s: %v", key, val)
+			}
+		}
+		return
+	})
 	if err != nil {
 		return
 	}
 	if response.StatusCode > 202 {
-		err = fmt.Errorf(
-			"Status code '%d' returned from server: '%s'",
-			response.StatusCode,
-			response.Status,
-		)
+		err = newAPIError(http.MethodHead, address, response, nil)
 		return
 	}
 	return
 }
-func (c *Connection) rawGet(path string, query url.Values) (output []byte, err error) {
-	// Send the request:
-	address := c.makeURL(path, c.version, query)
-	request, err := http.NewRequest(http.MethodGet, address, nil)
-	if err != nil {
-		return
-	}
-	c.setAgent(request)
-	c.setCredentials(request)
-	c.setAccept(request)
-	if glog.V(2) {
-		glog.Infof("Sending GET request to '%s'.", address)
-	}
-	if glog.V(3) {
-		glog.Info("Request headers:\n")
-		for key, val := range request.Header {
-			glog.Infof("	%s: %v", key, filterHeader(key, val))
-		}
-	}
-	response, err := c.client.Do(request)
-	if err != nil {
-		return
-	}
-	body := response.Body
-	defer body.Close()
-
-	// Read the response body:
-	output, err = ioutil.ReadAll(body)
-	if err != nil {
-		return
-	}
-	if glog.V(3) {
-		glog.Infof("Response body:\n%s", c.indent(filterJsonBytes(output)))
-		glog.Info("Response headers:")
-		for key, val := range response.Header {
-			glog.Infof("	%s: %v", key, filterHeader(key, val))
-		}
+func (c *Connection) rawGet(ctx context.Context, path string, query url.Values) (output []byte, err error) {
+	return c.rawRequest(ctx, http.MethodGet, path, query, nil, "application/json")
+}
 
-	}
-	if response.StatusCode > 202 {
-		err = fmt.Errorf(
-			"Status code '%d' returned from server: '%s'",
-			response.StatusCode,
-			response.Status,
-		)
+// authenticatedRawGet sends a GET request accepting the given media type, and returns the response
+// body without trying to decode it. It is intended for the few endpoints that can return
+// something different than JSON, like the output of jobs.
+//
+func (c *Connection) authenticatedRawGet(ctx context.Context, path string, query url.Values, accept string) (output []byte, err error) {
+	err = c.authenticated(ctx, func(ctx context.Context) (err error) {
+		output, err = c.rawRequest(ctx, http.MethodGet, path, query, nil, accept)
 		return
-	}
+	})
 	return
 }
 
-func (c *Connection) authenticatedPost(path string, query url.Values, input interface{}, output interface{}) error {
-	err := c.ensureToken()
-	if err != nil {
+func (c *Connection) authenticatedPost(ctx context.Context, path string, query url.Values, input interface{}, output interface{}) error {
+	return c.authenticated(ctx, func(ctx context.Context) error {
+		return c.post(ctx, path, query, input, output)
+	})
+}
+
+func (c *Connection) post(ctx context.Context, path string, query url.Values, input interface{}, output interface{}) error {
+	return c.send(ctx, http.MethodPost, path, query, input, output)
+}
+
+func (c *Connection) authenticatedPut(ctx context.Context, path string, query url.Values, input interface{}, output interface{}) error {
+	return c.authenticated(ctx, func(ctx context.Context) error {
+		return c.send(ctx, http.MethodPut, path, query, input, output)
+	})
+}
+
+func (c *Connection) authenticatedPatch(ctx context.Context, path string, query url.Values, input interface{}, output interface{}) error {
+	return c.authenticated(ctx, func(ctx context.Context) error {
+		return c.send(ctx, http.MethodPatch, path, query, input, output)
+	})
+}
+
+func (c *Connection) authenticatedDelete(ctx context.Context, path string, query url.Values) error {
+	return c.authenticated(ctx, func(ctx context.Context) error {
+		_, err := c.rawSend(ctx, http.MethodDelete, path, query, nil)
 		return err
-	}
-	return c.post(path, query, input, output)
+	})
 }
 
-func (c *Connection) post(path string, query url.Values, input interface{}, output interface{}) error {
+// send marshals the input, sends it to the server using the given method and unmarshals the
+// response body into the output. The output is left untouched if the server doesn't return a
+// body, as happens with most '204 No Content' responses, and the body is ignored if the output is
+// nil.
+//
+func (c *Connection) send(ctx context.Context, method, path string, query url.Values, input interface{}, output interface{}) error {
 	inputBytes, err := json.Marshal(input)
 	if err != nil {
 		return err
 	}
-	outputBytes, err := c.rawPost(path, query, inputBytes)
+	outputBytes, err := c.rawSend(ctx, method, path, query, inputBytes)
 	if err != nil {
 		return err
 	}
+	if len(outputBytes) == 0 || output == nil {
+		return nil
+	}
 	return json.Unmarshal(outputBytes, output)
 }
 
-func (c *Connection) rawPost(path string, query url.Values, input []byte) (output []byte, err error) {
-	// Post the input bytes:
-	address := c.makeURL(path, c.version, query)
-	buffer := bytes.NewBuffer(input)
-	request, err := http.NewRequest(http.MethodPost, address, buffer)
-	if err != nil {
+func (c *Connection) rawSend(ctx context.Context, method, path string, query url.Values, input []byte) (output []byte, err error) {
+	return c.rawRequest(ctx, method, path, query, input, "application/json")
+}
+
+// postForm sends a form encoded POST request to the given path, relative to the given prefix, and
+// decodes the JSON response into the output. It is used for the OAuth2 endpoints, which don't
+// accept JSON. The form isn't logged, as it usually contains passwords or tokens.
+//
+func (c *Connection) postForm(ctx context.Context, path, prefix string, form url.Values, output interface{}) error {
+	address := c.makeURL(path, prefix, nil)
+	input := form.Encode()
+	response, body, err := c.do(ctx, http.MethodPost, func() (request *http.Request, err error) {
+		request, err = http.NewRequestWithContext(ctx, http.MethodPost, address, strings.NewReader(input))
+		if err != nil {
+			return
+		}
+		c.setAgent(request)
+		c.setCredentials(request)
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		c.setAccept(request, "application/json")
+		if glog.V(2) {
+			glog.Infof("Sending POST request to '%s'.", address)
+		}
 		return
+	})
+	if err != nil {
+		return err
 	}
-	c.setAgent(request)
-	c.setCredentials(request)
-	c.setContentType(request)
-	c.setAccept(request)
-	if glog.V(2) {
-		glog.Infof("Sending POST request to '%s'.", address)
+	if response.StatusCode > 299 {
+		return newAPIError(http.MethodPost, address, response, body)
 	}
-	if glog.V(3) {
-		glog.Infof("Request body:\n%s", c.indent(filterJsonBytes(input)))
-		glog.Infof("Request headers:")
-		for key, val := range request.Header {
-			glog.Infof("	%s: %v", key, filterHeader(key, val))
-		}
-	}
-	response, err := c.client.Do(request)
-	if err != nil {
-		return
+	if len(body) == 0 || output == nil {
+		return nil
 	}
-	body := response.Body
-	defer body.Close()
+	return json.Unmarshal(body, output)
+}
 
-	// Read the response body:
-	output, err = ioutil.ReadAll(body)
+func (c *Connection) rawRequest(ctx context.Context, method, path string, query url.Values, input []byte, accept string) (output []byte, err error) {
+	address := c.makeURL(path, c.version, query)
+	response, output, err := c.do(ctx, method, func() (request *http.Request, err error) {
+		// Send the input bytes, if any:
+		if input != nil {
+			request, err = http.NewRequestWithContext(ctx, method, address, bytes.NewBuffer(input))
+		} else {
+			request, err = http.NewRequestWithContext(ctx, method, address, nil)
+		}
+		if err != nil {
+			return
+		}
+		c.setAgent(request)
+		c.setCredentials(request)
+		if input != nil {
+			c.setContentType(request)
+		}
+		c.setAccept(request, accept)
+		if glog.V(2) {
+			glog.Infof("Sending %s request to '%s'.", method, address)
+		}
+		if glog.V(3) {
+			if input != nil {
+				glog.Infof("Request body:\n%s", c.indent(filterJsonBytes(input)))
+			}
+			glog.Infof("Request headers:")
+			for key, val := range request.Header {
+				glog.Infof("	%s: %v", key, filterHeader(key, val))
+			}
+		}
+		return
+	})
 	if err != nil {
 		return
 	}
 	if glog.V(3) {
-		glog.Infof("Response body:\n%s", c.indent(filterJsonBytes(output)))
+		if accept == "application/json" {
+			glog.Infof("Response body:\n%s", c.indent(filterJsonBytes(output)))
+		} else {
+			glog.Infof("Response body:\n%s", output)
+		}
 		glog.Info("Response headers:")
 		for key, val := range response.Header {
-			glog.Infof("	%s: %v", key, val)
+			glog.Infof("	%s: %v", key, filterHeader(key, val))
 		}
 	}
-	if response.StatusCode > 202 {
-		err = fmt.Errorf(
-			"Status code '%d' returned from server: '%s'",
-			response.StatusCode,
-			response.Status,
-		)
+	if response.StatusCode > 299 {
+		err = newAPIError(method, address, response, output)
+		output = nil
 		return
 	}
 	return
 }
 
+// do sends the request created by the given function and reads the response body, retrying
+// according to the retry policy of the connection. The function is called for each attempt,
+// because a request can't be sent more than once.
+//
+func (c *Connection) do(ctx context.Context, method string, build func() (*http.Request, error)) (response *http.Response, body []byte, err error) {
+	attempt := 1
+	for {
+		var request *http.Request
+		request, err = build()
+		if err != nil {
+			return
+		}
+		response, err = c.client.Do(request)
+		if err == nil {
+			body, err = ioutil.ReadAll(response.Body)
+			response.Body.Close()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		delay, retry := c.retry.delay(method, attempt, response, err)
+		if !retry {
+			return
+		}
+		if glog.V(2) {
+			if err != nil {
+				glog.Infof("Request %s '%s' failed, will retry in %s: %s", method, request.URL, delay, err)
+			} else {
+				glog.Infof("Request %s '%s' returned status code %d, will retry in %s", method, request.URL, response.StatusCode, delay)
+			}
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = ctx.Err()
+			return
+		case <-timer.C:
+		}
+		attempt++
+	}
+}
+
 func (c *Connection) setAgent(request *http.Request) {
 	request.Header.Set("User-Agent", c.agent)
 }
 
+// setCredentials adds to the request the 'Authorization' header stored in its context, if any.
+//
 func (c *Connection) setCredentials(request *http.Request) {
-	if c.token != "" {
-		request.Header.Set("Authorization", "Token "+c.token)
-	} else if c.bearer != "" {
-		request.Header.Set("Authorization", "Bearer "+c.bearer)
-	} else if c.username != "" {
-		request.SetBasicAuth(c.username, c.password)
+	authorization := authorizationFrom(request.Context())
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
 	}
 }
 
@@ -576,8 +812,8 @@ func (c *Connection) setContentType(request *http.Request) {
 	request.Header.Set("Content-Type", "application/json")
 }
 
-func (c *Connection) setAccept(request *http.Request) {
-	request.Header.Set("Accept", "application/json")
+func (c *Connection) setAccept(request *http.Request, accept string) {
+	request.Header.Set("Accept", accept)
 }
 
 func (c *Connection) indent(data []byte) []byte {
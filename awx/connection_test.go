@@ -77,7 +77,12 @@ func TestOAUTH2Token(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	defer connection.Close()
+	defer func() {
+		err := connection.Close()
+		if err != nil {
+			t.Errorf("Error closing connection: %s", err)
+		}
+	}()
 	vcr := govcr.NewVCR("connection_oauth2",
 		&govcr.VCRConfig{
 			Client:           connection.client,
@@ -86,22 +91,23 @@ func TestOAUTH2Token(t *testing.T) {
 	// Replace our HTTPClient with a vcr client wrapping it
 	connection.client = vcr.Client
 	projectsResource := connection.Projects()
+	credentials := connection.credentials.(*tokenCredentials)
 
 	// Trigger the auth flow.
 	getProjectsRequest := projectsResource.Get()
-	if len(connection.token) != 0 || len(connection.bearer) != 0 {
+	if len(credentials.token) != 0 || len(credentials.bearer) != 0 {
 		t.Errorf("Connection should have no tokens. token: '%s', bearer: '%s'",
-			connection.token,
-			connection.bearer)
+			credentials.token,
+			credentials.bearer)
 	}
 	_, err = getProjectsRequest.Send()
 	if err != nil {
 		panic(err)
 	}
-	if len(connection.token) != 0 || len(connection.bearer) == 0 {
+	if len(credentials.token) != 0 || len(credentials.bearer) == 0 {
 		t.Errorf("Connection should have only a bearer token. token: '%s', bearer: '%s'",
-			connection.token,
-			connection.bearer)
+			credentials.token,
+			credentials.bearer)
 	}
 }
 
@@ -122,7 +128,12 @@ func TestPreOAUTH2(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error creating connection: %s", err)
 	}
-	defer connection.Close()
+	defer func() {
+		err := connection.Close()
+		if err != nil {
+			t.Errorf("Error closing connection: %s", err)
+		}
+	}()
 	vcr := govcr.NewVCR("connection_pre_oauth2",
 		&govcr.VCRConfig{
 			Client:           connection.client,
@@ -131,21 +142,22 @@ func TestPreOAUTH2(t *testing.T) {
 	// Replace our HTTPClient with a vcr client wrapping it
 	connection.client = vcr.Client
 	projectsResource := connection.Projects()
+	credentials := connection.credentials.(*tokenCredentials)
 
 	// Trigger the auth flow.
 	getProjectsRequest := projectsResource.Get()
-	if len(connection.token) != 0 || len(connection.bearer) != 0 {
+	if len(credentials.token) != 0 || len(credentials.bearer) != 0 {
 		t.Errorf("Connection should have no tokens. token: '%s', bearer: '%s'",
-			connection.token,
-			connection.bearer)
+			credentials.token,
+			credentials.bearer)
 	}
 	_, err = getProjectsRequest.Send()
 	if err != nil {
 		t.Errorf("Error sending project request: %s", err)
 	}
-	if len(connection.token) == 0 || len(connection.bearer) != 0 {
+	if len(credentials.token) == 0 || len(credentials.bearer) != 0 {
 		t.Errorf("Connection should have only an auth token. token: '%s', bearer: '%s'",
-			connection.token,
-			connection.bearer)
+			credentials.token,
+			credentials.bearer)
 	}
 }
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the use of contexts to cancel requests.
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newSlowServer creates a server that doesn't respond to requests till it is closed.
+func newSlowServer() (server *httptest.Server, release func()) {
+	done := make(chan struct{})
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	release = func() {
+		close(done)
+		server.Close()
+	}
+	return
+}
+
+func TestSendContextDeadline(t *testing.T) {
+	server, release := newSlowServer()
+	defer release()
+	connection := newTestConnection(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := connection.Projects().Get().SendContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded error, got %v", err)
+	}
+}
+
+func TestSendContextTokenAcquisition(t *testing.T) {
+	server, release := newSlowServer()
+	defer release()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Username("admin").
+		Password("password").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = connection.Projects().Get().SendContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected cancelled error, got %v", err)
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the interface used by the connection to obtain the credentials that it sends
+// to the server, and the simplest implementations of that interface.
+
+package awx
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CredentialsProvider is the interface that the connection uses to obtain the value of the
+// 'Authorization' header that it sends with each request. Implementations must be safe to use from
+// multiple goroutines.
+//
+// If the provider also implements the CredentialsInvalidator interface then the connection will
+// call its Invalidate method when the server rejects the credentials, and will then ask for new
+// credentials and send the request again, once. If the provider implements the io.Closer interface
+// then the connection will call its Close method when the connection is closed.
+//
+type CredentialsProvider interface {
+	// Authorization returns the complete value of the 'Authorization' header, including the
+	// scheme, for example 'Bearer mytoken'.
+	Authorization(ctx context.Context) (string, error)
+}
+
+// CredentialsInvalidator is the interface implemented by credentials providers that can discard
+// credentials that have been rejected by the server, and obtain new ones.
+//
+type CredentialsInvalidator interface {
+	// Invalidate is called with the value of the 'Authorization' header that the server
+	// rejected.
+	Invalidate(authorization string)
+}
+
+// credentialsBinder is the interface implemented by the credentials providers that need to send
+// requests to the server in order to obtain credentials. The connection calls the bind method
+// when it is built.
+//
+type credentialsBinder interface {
+	bind(connection *Connection)
+}
+
+// authorizationKey is the key used to store in the context the value of the 'Authorization' header
+// that should be used for a request.
+//
+type authorizationKey struct{}
+
+// withAuthorization returns a copy of the context containing the given value of the
+// 'Authorization' header.
+//
+func withAuthorization(ctx context.Context, authorization string) context.Context {
+	return context.WithValue(ctx, authorizationKey{}, authorization)
+}
+
+// authorizationFrom returns the value of the 'Authorization' header stored in the context, or an
+// empty string if there is none.
+//
+func authorizationFrom(ctx context.Context) string {
+	authorization, _ := ctx.Value(authorizationKey{}).(string)
+	return authorization
+}
+
+// basicAuthorization calculates the value of the 'Authorization' header for the given user name
+// and password.
+//
+func basicAuthorization(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+// staticCredentials is a provider that always returns the same value.
+//
+type staticCredentials string
+
+func (s staticCredentials) Authorization(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+// BasicCredentials returns a provider that sends the given user name and password with each
+// request, using basic authentication.
+//
+func BasicCredentials(username, password string) CredentialsProvider {
+	return staticCredentials(basicAuthorization(username, password))
+}
+
+// BearerCredentials returns a provider that sends the given OAuth2 token with each request.
+//
+func BearerCredentials(token string) CredentialsProvider {
+	return staticCredentials("Bearer " + token)
+}
+
+// TokenCredentials returns a provider that sends with each request the given token, obtained from
+// the authtoken endpoint supported by AWX < 1.0.5 and Ansible Tower < 3.3.
+//
+func TokenCredentials(token string) CredentialsProvider {
+	return staticCredentials("Token " + token)
+}
+
+// EnvCredentials returns a provider that reads the credentials from environment variables each
+// time that they are needed. The variables are checked in this order: AWX_BEARER for an OAuth2
+// token, AWX_TOKEN for a token obtained from the authtoken endpoint, and AWX_USERNAME and
+// AWX_PASSWORD for basic authentication.
+//
+func EnvCredentials() CredentialsProvider {
+	return envCredentials{}
+}
+
+type envCredentials struct{}
+
+func (envCredentials) Authorization(ctx context.Context) (string, error) {
+	if bearer := os.Getenv("AWX_BEARER"); bearer != "" {
+		return "Bearer " + bearer, nil
+	}
+	if token := os.Getenv("AWX_TOKEN"); token != "" {
+		return "Token " + token, nil
+	}
+	if username := os.Getenv("AWX_USERNAME"); username != "" {
+		return basicAuthorization(username, os.Getenv("AWX_PASSWORD")), nil
+	}
+	return "", fmt.Errorf(
+		"None of the environment variables AWX_BEARER, AWX_TOKEN or AWX_USERNAME is set",
+	)
+}
+
+// FileCredentials returns a provider that reads an OAuth2 token from the given file. The file is
+// read again when it changes, so that tokens rotated by other processes, for example by a secret
+// manager, are used without having to create a new connection. Leading and trailing white space
+// is ignored.
+//
+func FileCredentials(path string) CredentialsProvider {
+	return &fileCredentials{
+		path: path,
+	}
+}
+
+type fileCredentials struct {
+	path string
+
+	// The lock protects the token and the modification time and size of the file when it was
+	// read:
+	lock    sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+func (f *fileCredentials) Authorization(ctx context.Context) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("Can't check credentials file '%s': %w", f.path, err)
+	}
+	if f.token == "" || !info.ModTime().Equal(f.modTime) || info.Size() != f.size {
+		content, err := ioutil.ReadFile(f.path)
+		if err != nil {
+			return "", fmt.Errorf("Can't read credentials file '%s': %w", f.path, err)
+		}
+		token := strings.TrimSpace(string(content))
+		if token == "" {
+			return "", fmt.Errorf("Credentials file '%s' is empty", f.path)
+		}
+		f.token = token
+		f.modTime = info.ModTime()
+		f.size = info.Size()
+	}
+	return "Bearer " + f.token, nil
+}
+
+// Invalidate forces the file to be read again, even if it hasn't changed, as the modification
+// time may not have enough resolution to detect quick changes.
+//
+func (f *fileCredentials) Invalidate(authorization string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if "Bearer "+f.token == authorization {
+		f.token = ""
+	}
+}
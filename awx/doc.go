@@ -16,4 +16,10 @@ limitations under the License.
 
 // This package contains the AWX client.
 //
+// All the requests have a Send method and a SendContext method. The SendContext method uses the
+// given context for all the HTTP requests that it needs to send, including the requests to obtain
+// authentication tokens, so that they can be cancelled or given a deadline. The Send method is
+// equivalent to SendContext with the background context. In the same way, the methods that
+// retrieve all the pages of a list have EachContext and AllContext variants.
+//
 package awx
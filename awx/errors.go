@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the error returned when the server responds to a
+// request with an error status code.
+
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError is the error returned when the server responds to a request with a status code that
+// indicates a failure. It contains the details of the request and of the response, including the
+// messages that the server sends to explain the failure.
+//
+type APIError struct {
+	statusCode  int
+	status      string
+	method      string
+	url         string
+	body        []byte
+	detail      string
+	fieldErrors map[string][]string
+}
+
+// newAPIError creates an error for the given response. The body is decoded if it is a JSON object
+// like the ones that the server uses to report errors, for example:
+//
+//	{"detail": "Not found."}
+//
+// Or, for validation errors:
+//
+//	{"name": ["This field is required."], "__all__": ["..."]}
+//
+func newAPIError(method, url string, response *http.Response, body []byte) *APIError {
+	e := new(APIError)
+	e.statusCode = response.StatusCode
+	e.status = response.Status
+	e.method = method
+	e.url = url
+	e.body = body
+	var fields map[string]interface{}
+	if json.Unmarshal(body, &fields) != nil {
+		return e
+	}
+	for name, value := range fields {
+		var messages []string
+		switch typed := value.(type) {
+		case string:
+			messages = []string{typed}
+		case []interface{}:
+			for _, item := range typed {
+				messages = append(messages, fmt.Sprintf("%v", item))
+			}
+		default:
+			messages = []string{fmt.Sprintf("%v", typed)}
+		}
+		switch name {
+		case "detail", "error":
+			if e.detail == "" {
+				e.detail = strings.Join(messages, " ")
+			}
+		default:
+			if e.fieldErrors == nil {
+				e.fieldErrors = make(map[string][]string)
+			}
+			e.fieldErrors[name] = messages
+		}
+	}
+	return e
+}
+
+// StatusCode returns the HTTP status code returned by the server, for example 404.
+//
+func (e *APIError) StatusCode() int {
+	return e.statusCode
+}
+
+// Status returns the HTTP status line returned by the server, for example '404 Not Found'.
+//
+func (e *APIError) Status() string {
+	return e.status
+}
+
+// Method returns the HTTP method of the request that failed.
+//
+func (e *APIError) Method() string {
+	return e.method
+}
+
+// URL returns the URL of the request that failed.
+//
+func (e *APIError) URL() string {
+	return e.url
+}
+
+// Body returns the raw body of the response.
+//
+func (e *APIError) Body() []byte {
+	return e.body
+}
+
+// Detail returns the general explanation of the error sent by the server, if any.
+//
+func (e *APIError) Detail() string {
+	return e.detail
+}
+
+// FieldErrors returns the error messages sent by the server for each field of the request, indexed
+// by field name. Errors that don't refer to a particular field use names like '__all__' or
+// 'non_field_errors'.
+//
+func (e *APIError) FieldErrors() map[string][]string {
+	return e.fieldErrors
+}
+
+func (e *APIError) Error() string {
+	buffer := new(strings.Builder)
+	fmt.Fprintf(
+		buffer,
+		"Status code '%d' returned from server for %s '%s': '%s'",
+		e.statusCode,
+		e.method,
+		e.url,
+		e.status,
+	)
+	if e.detail != "" {
+		fmt.Fprintf(buffer, ": %s", e.detail)
+	}
+	if len(e.fieldErrors) > 0 {
+		names := make([]string, 0, len(e.fieldErrors))
+		for name := range e.fieldErrors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(buffer, ": %s: %s", name, strings.Join(e.fieldErrors[name], " "))
+		}
+	}
+	return buffer.String()
+}
+
+// asAPIError returns the API error contained in the given error, or nil if there is none.
+//
+func asAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	return nil
+}
+
+// IsNotFound returns true if the error was caused by the server responding with status code 404.
+//
+func IsNotFound(err error) bool {
+	apiErr := asAPIError(err)
+	return apiErr != nil && apiErr.statusCode == http.StatusNotFound
+}
+
+// IsUnauthorized returns true if the error was caused by the server responding with status code
+// 401, meaning that the credentials are missing or invalid.
+//
+func IsUnauthorized(err error) bool {
+	apiErr := asAPIError(err)
+	return apiErr != nil && apiErr.statusCode == http.StatusUnauthorized
+}
+
+// IsForbidden returns true if the error was caused by the server responding with status code 403,
+// meaning that the user doesn't have permission to perform the operation.
+//
+func IsForbidden(err error) bool {
+	apiErr := asAPIError(err)
+	return apiErr != nil && apiErr.statusCode == http.StatusForbidden
+}
+
+// IsConflict returns true if the error was caused by the server responding with status code 409,
+// for example when trying to delete an object that is being used.
+//
+func IsConflict(err error) bool {
+	apiErr := asAPIError(err)
+	return apiErr != nil && apiErr.statusCode == http.StatusConflict
+}
+
+// IsValidation returns true if the error was caused by the server rejecting the request because it
+// isn't valid, with status code 400, or if it is the error returned when a launch request is found
+// not to satisfy the requirements of the job template before sending it.
+//
+func IsValidation(err error) bool {
+	var launchErr *JobTemplateLaunchValidationError
+	if errors.As(err, &launchErr) {
+		return true
+	}
+	apiErr := asAPIError(err)
+	return apiErr != nil && apiErr.statusCode == http.StatusBadRequest
+}
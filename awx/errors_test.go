@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the errors returned by the server.
+
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newErrorServer creates a server that responds to all requests with the given status code and
+// body.
+func newErrorServer(code int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		fmt.Fprint(w, body)
+	}))
+}
+
+func TestAPIErrorNotFound(t *testing.T) {
+	server := newErrorServer(http.StatusNotFound, `{"detail": "Not found."}`)
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	_, err := connection.Projects().Id(123).Get().Send()
+	if !IsNotFound(err) {
+		t.Fatalf("Expected not found error, got %v", err)
+	}
+	if IsUnauthorized(err) || IsConflict(err) || IsValidation(err) {
+		t.Errorf("Expected only not found to be true for %v", err)
+	}
+	apiErr := err.(*APIError)
+	if apiErr.Method() != http.MethodGet {
+		t.Errorf("Expected method GET, got '%s'", apiErr.Method())
+	}
+	if !strings.HasSuffix(apiErr.URL(), "/api/v2/projects/123/") {
+		t.Errorf("Unexpected URL '%s'", apiErr.URL())
+	}
+	if apiErr.Detail() != "Not found." {
+		t.Errorf("Expected detail 'Not found.', got '%s'", apiErr.Detail())
+	}
+	if !strings.Contains(err.Error(), "Not found.") {
+		t.Errorf("Expected error message to contain the detail, got '%s'", err.Error())
+	}
+}
+
+func TestAPIErrorValidation(t *testing.T) {
+	body := `{"name": ["This field is required."], "__all__": ["Project with this Name already exists."]}`
+	server := newErrorServer(http.StatusBadRequest, body)
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	_, err := connection.Projects().Post().Send()
+	if !IsValidation(err) {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+	apiErr := err.(*APIError)
+	if apiErr.StatusCode() != http.StatusBadRequest || apiErr.Method() != http.MethodPost {
+		t.Errorf("Unexpected status code %d or method '%s'", apiErr.StatusCode(), apiErr.Method())
+	}
+	if string(apiErr.Body()) != body {
+		t.Errorf("Expected body '%s', got '%s'", body, apiErr.Body())
+	}
+	expected := map[string][]string{
+		"name":    {"This field is required."},
+		"__all__": {"Project with this Name already exists."},
+	}
+	if !reflect.DeepEqual(apiErr.FieldErrors(), expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, apiErr.FieldErrors())
+	}
+}
+
+func TestAPIErrorNotJSON(t *testing.T) {
+	server := newErrorServer(http.StatusConflict, "<html>Conflict</html>")
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	_, err := connection.Inventories().Id(1).Delete().Send()
+	if !IsConflict(err) {
+		t.Fatalf("Expected conflict error, got %v", err)
+	}
+	apiErr := err.(*APIError)
+	if apiErr.Detail() != "" || apiErr.FieldErrors() != nil {
+		t.Errorf("Expected no detail or field errors, got '%s' and %v", apiErr.Detail(), apiErr.FieldErrors())
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the group type.
+
+package awx
+
+import (
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// Group represents an AWX inventory group.
+//
+type Group struct {
+	id                      int
+	name                    string
+	description             string
+	inventory               int
+	variables               map[string]interface{}
+	hasActiveFailures       bool
+	totalHosts              int
+	hostsWithActiveFailures int
+	totalGroups             int
+	hasInventorySources     bool
+}
+
+// newGroup creates a new group from the data returned by the server.
+//
+func newGroup(output *data.Group) *Group {
+	group := new(Group)
+	group.id = output.Id
+	group.name = output.Name
+	group.description = output.Description
+	group.inventory = output.Inventory
+	group.variables = decodeVariables(output.Variables)
+	group.hasActiveFailures = output.HasActiveFailures
+	group.totalHosts = output.TotalHosts
+	group.hostsWithActiveFailures = output.HostsWithActiveFailures
+	group.totalGroups = output.TotalGroups
+	group.hasInventorySources = output.HasInventorySources
+	return group
+}
+
+// Id returns the unique identifier of the group.
+//
+func (g *Group) Id() int {
+	return g.id
+}
+
+// Name returns the name of the group.
+//
+func (g *Group) Name() string {
+	return g.name
+}
+
+// Description returns the description of the group.
+//
+func (g *Group) Description() string {
+	return g.description
+}
+
+// Inventory returns the identifier of the inventory that the group belongs to.
+//
+func (g *Group) Inventory() int {
+	return g.inventory
+}
+
+// Variables returns the group variables. It returns nil if the server stored them as YAML instead
+// of JSON, use the VariableData resource of the group to get them decoded by the server in that
+// case.
+//
+func (g *Group) Variables() map[string]interface{} {
+	return g.variables
+}
+
+// HasActiveFailures returns true if any of the hosts of the group failed in the last job.
+//
+func (g *Group) HasActiveFailures() bool {
+	return g.hasActiveFailures
+}
+
+// TotalHosts returns the number of hosts in the group and its children.
+//
+func (g *Group) TotalHosts() int {
+	return g.totalHosts
+}
+
+// HostsWithActiveFailures returns the number of hosts of the group that failed in the last job.
+//
+func (g *Group) HostsWithActiveFailures() int {
+	return g.hostsWithActiveFailures
+}
+
+// TotalGroups returns the number of child groups of the group.
+//
+func (g *Group) TotalGroups() int {
+	return g.totalGroups
+}
+
+// HasInventorySources returns true if the group was created by an external inventory source.
+//
+func (g *Group) HasInventorySources() bool {
+	return g.hasInventorySources
+}
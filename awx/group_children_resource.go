@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages the child groups of a
+// group.
+
+package awx
+
+// GroupChildrenResource is the collection of child groups of a group. In addition to listing and
+// creating groups it can add existing groups as children, or remove them without deleting them.
+//
+type GroupChildrenResource struct {
+	GroupsResource
+}
+
+func NewGroupChildrenResource(connection *Connection, path string) *GroupChildrenResource {
+	resource := new(GroupChildrenResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+// Associate returns a request that adds the existing group with the given identifier as a child
+// of the group.
+//
+func (r *GroupChildrenResource) Associate(id int) *AssociationPostRequest {
+	return newAssociationPostRequest(&r.Resource, id, false)
+}
+
+// Disassociate returns a request that removes the child group with the given identifier from the
+// group. The child group itself isn't deleted.
+//
+func (r *GroupChildrenResource) Disassociate(id int) *AssociationPostRequest {
+	return newAssociationPostRequest(&r.Resource, id, true)
+}
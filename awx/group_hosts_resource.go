@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages the hosts that are members
+// of a group.
+
+package awx
+
+// GroupHostsResource is the collection of hosts of a group. In addition to listing and creating
+// hosts it can add existing hosts to the group, or remove them from it without deleting them.
+//
+type GroupHostsResource struct {
+	HostsResource
+}
+
+func NewGroupHostsResource(connection *Connection, path string) *GroupHostsResource {
+	resource := new(GroupHostsResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+// Associate returns a request that adds the existing host with the given identifier to the group.
+//
+func (r *GroupHostsResource) Associate(id int) *AssociationPostRequest {
+	return newAssociationPostRequest(&r.Resource, id, false)
+}
+
+// Disassociate returns a request that removes the host with the given identifier from the group.
+// The host itself isn't deleted.
+//
+func (r *GroupHostsResource) Disassociate(id int) *AssociationPostRequest {
+	return newAssociationPostRequest(&r.Resource, id, true)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a specific group.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type GroupResource struct {
+	Resource
+}
+
+func NewGroupResource(connection *Connection, path string) *GroupResource {
+	resource := new(GroupResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *GroupResource) Get() *GroupGetRequest {
+	request := new(GroupGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *GroupResource) Patch() *GroupPatchRequest {
+	request := new(GroupPatchRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *GroupResource) Delete() *GroupDeleteRequest {
+	request := new(GroupDeleteRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Hosts returns a reference to the resource that manages the hosts that are direct members of
+// this group.
+//
+func (r *GroupResource) Hosts() *GroupHostsResource {
+	return NewGroupHostsResource(r.connection, r.path+"/hosts")
+}
+
+// Children returns a reference to the resource that manages the child groups of this group.
+//
+func (r *GroupResource) Children() *GroupChildrenResource {
+	return NewGroupChildrenResource(r.connection, r.path+"/children")
+}
+
+// VariableData returns a reference to the resource that manages the variables of this group.
+//
+func (r *GroupResource) VariableData() *VariableDataResource {
+	return NewVariableDataResource(r.connection, r.path+"/variable_data")
+}
+
+type GroupGetRequest struct {
+	Request
+}
+
+func (r *GroupGetRequest) Send() (response *GroupGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *GroupGetRequest) SendContext(ctx context.Context) (response *GroupGetResponse, err error) {
+	output := new(data.GroupGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(GroupGetResponse)
+	response.result = newGroup(&output.Group)
+	return
+}
+
+type GroupGetResponse struct {
+	result *Group
+}
+
+func (r *GroupGetResponse) Result() *Group {
+	return r.result
+}
+
+// GroupPatchRequest modifies the group. Only the fields that are explicitly set are sent to the
+// server, the rest are left untouched.
+//
+type GroupPatchRequest struct {
+	Request
+
+	input     data.GroupPatchRequest
+	variables map[string]interface{}
+}
+
+func (r *GroupPatchRequest) Name(value string) *GroupPatchRequest {
+	r.input.Name = &value
+	return r
+}
+
+func (r *GroupPatchRequest) Description(value string) *GroupPatchRequest {
+	r.input.Description = &value
+	return r
+}
+
+func (r *GroupPatchRequest) Inventory(value int) *GroupPatchRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+// Variables replaces the complete map of group variables. To change only some of the variables
+// use the Patch request of the VariableData resource of the group.
+//
+func (r *GroupPatchRequest) Variables(value map[string]interface{}) *GroupPatchRequest {
+	r.variables = value
+	return r
+}
+
+func (r *GroupPatchRequest) Variable(name string, value interface{}) *GroupPatchRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *GroupPatchRequest) Send() (response *GroupPatchResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *GroupPatchRequest) SendContext(ctx context.Context) (response *GroupPatchResponse, err error) {
+	r.input.Variables, err = encodeVariables(r.variables)
+	if err != nil {
+		return
+	}
+	output := new(data.GroupPatchResponse)
+	err = r.patch(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(GroupPatchResponse)
+	response.result = newGroup(&output.Group)
+	return
+}
+
+type GroupPatchResponse struct {
+	result *Group
+}
+
+func (r *GroupPatchResponse) Result() *Group {
+	return r.result
+}
+
+type GroupDeleteRequest struct {
+	Request
+}
+
+func (r *GroupDeleteRequest) Send() (response *GroupDeleteResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *GroupDeleteRequest) SendContext(ctx context.Context) (response *GroupDeleteResponse, err error) {
+	err = r.delete(ctx)
+	if err != nil {
+		return
+	}
+	response = new(GroupDeleteResponse)
+	return
+}
+
+type GroupDeleteResponse struct {
+}
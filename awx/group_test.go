@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the groups resources.
+
+package awx
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestInventoryGroupsGetFilter(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/inventories/3/groups/", http.StatusOK, `{
+		"count": 1,
+		"results": [{
+			"id": 5,
+			"name": "web",
+			"inventory": 3,
+			"variables": "{\"http_port\": 8080}",
+			"total_hosts": 2
+		}]
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Id(3).Groups().Get().
+		Filter("name__startswith", "web").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := server.last(t, http.MethodGet, "/api/v2/inventories/3/groups/").query
+	if query.Get("name__startswith") != "web" {
+		t.Errorf("Expected the name filter, got '%s'", query.Encode())
+	}
+	results := response.Results()
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 group, got %d", len(results))
+	}
+	group := results[0]
+	if group.Id() != 5 || group.Name() != "web" || group.Inventory() != 3 || group.TotalHosts() != 2 {
+		t.Errorf("Unexpected group %+v", group)
+	}
+	expected := map[string]interface{}{
+		"http_port": 8080.0,
+	}
+	if !reflect.DeepEqual(group.Variables(), expected) {
+		t.Errorf("Expected variables %v, got %v", expected, group.Variables())
+	}
+}
+
+func TestInventoryGroupsPost(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/inventories/3/groups/", http.StatusCreated, `{
+		"id": 5,
+		"name": "web",
+		"inventory": 3
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Id(3).Groups().Post().
+		Name("web").
+		Description("Web servers").
+		Variable("http_port", 8080).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/inventories/3/groups/").body
+	if body["name"] != "web" || body["description"] != "Web servers" {
+		t.Errorf("Expected the name and description, got %v", body)
+	}
+	variables := decodeVariablesField(t, body, "variables")
+	if variables["http_port"] != 8080.0 {
+		t.Errorf("Expected variable 'http_port' to be 8080, got %v", variables)
+	}
+	group := response.Result()
+	if group.Id() != 5 || group.Name() != "web" || group.Inventory() != 3 {
+		t.Errorf("Unexpected group %+v", group)
+	}
+}
+
+func TestGroupPatchSendsOnlyChanges(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPatch, "/api/v2/groups/5/", http.StatusOK, `{"id": 5, "name": "www"}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Id(3).Groups().Id(5).Patch().
+		Name("www").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPatch, "/api/v2/groups/5/").body
+	expected := map[string]interface{}{
+		"name": "www",
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+	if response.Result().Name() != "www" {
+		t.Errorf("Expected name 'www', got '%s'", response.Result().Name())
+	}
+}
+
+func TestGroupDelete(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodDelete, "/api/v2/groups/5/", http.StatusNoContent, "")
+	connection := server.connect(t)
+
+	_, err := connection.Groups().Id(5).Delete().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.count(http.MethodDelete, "/api/v2/groups/5/") != 1 {
+		t.Errorf("Expected the group to be deleted, got %v", server.recorded())
+	}
+}
+
+func TestGroupAssociate(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/groups/5/hosts/", http.StatusNoContent, "")
+	server.respond(http.MethodPost, "/api/v2/groups/5/children/", http.StatusNoContent, "")
+	connection := server.connect(t)
+
+	group := connection.Groups().Id(5)
+	_, err := group.Hosts().Associate(7).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = group.Children().Associate(6).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/groups/5/hosts/").body
+	expected := map[string]interface{}{
+		"id": 7.0,
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected host association body %v, got %v", expected, body)
+	}
+	body = server.last(t, http.MethodPost, "/api/v2/groups/5/children/").body
+	expected = map[string]interface{}{
+		"id": 6.0,
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected child association body %v, got %v", expected, body)
+	}
+}
+
+func TestGroupDisassociate(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/groups/5/hosts/", http.StatusNoContent, "")
+	server.respond(http.MethodPost, "/api/v2/groups/5/children/", http.StatusNoContent, "")
+	connection := server.connect(t)
+
+	group := connection.Groups().Id(5)
+	_, err := group.Hosts().Disassociate(7).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = group.Children().Disassociate(6).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/groups/5/hosts/").body
+	expected := map[string]interface{}{
+		"id":           7.0,
+		"disassociate": true,
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected host disassociation body %v, got %v", expected, body)
+	}
+	body = server.last(t, http.MethodPost, "/api/v2/groups/5/children/").body
+	expected = map[string]interface{}{
+		"id":           6.0,
+		"disassociate": true,
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected child disassociation body %v, got %v", expected, body)
+	}
+}
+
+func TestGroupAssociationRejected(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(
+		http.MethodPost, "/api/v2/groups/5/children/", http.StatusBadRequest,
+		`{"error": "Cyclical Group association."}`,
+	)
+	connection := server.connect(t)
+
+	_, err := connection.Groups().Id(5).Children().Associate(5).Send()
+	if err == nil {
+		t.Errorf("Expected the association to fail")
+	}
+}
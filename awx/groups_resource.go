@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a collection of groups,
+// either all the groups or the groups of an specific inventory.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type GroupsResource struct {
+	Resource
+}
+
+func NewGroupsResource(connection *Connection, path string) *GroupsResource {
+	resource := new(GroupsResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *GroupsResource) Get() *GroupsGetRequest {
+	request := new(GroupsGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *GroupsResource) Post() *GroupsPostRequest {
+	request := new(GroupsPostRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Id returns the resource that manages the group with the given identifier. Note that groups are
+// always managed using the top level 'groups' collection, even when this resource is the
+// collection of groups of an inventory or the children of another group.
+//
+func (r *GroupsResource) Id(id int) *GroupResource {
+	return NewGroupResource(r.connection, fmt.Sprintf("groups/%d", id))
+}
+
+type GroupsGetRequest struct {
+	Request
+}
+
+func (r *GroupsGetRequest) Filter(name string, value interface{}) *GroupsGetRequest {
+	r.addFilter(name, value)
+	return r
+}
+
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *GroupsGetRequest) PageSize(value int) *GroupsGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the groups.
+// It stops and returns the error if the function returns an error.
+//
+func (r *GroupsGetRequest) Each(f func(group *Group) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *GroupsGetRequest) EachContext(ctx context.Context, f func(group *Group) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, group := range response.results {
+			err = f(group)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the groups.
+//
+func (r *GroupsGetRequest) All() (results []*Group, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *GroupsGetRequest) AllContext(ctx context.Context) (results []*Group, err error) {
+	err = r.EachContext(ctx, func(group *Group) error {
+		results = append(results, group)
+		return nil
+	})
+	return
+}
+
+func (r *GroupsGetRequest) Send() (response *GroupsGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *GroupsGetRequest) SendContext(ctx context.Context) (response *GroupsGetResponse, err error) {
+	output := new(data.GroupsGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(GroupsGetResponse)
+	response.count = output.Count
+	response.previous = output.Previous
+	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
+	response.results = make([]*Group, len(output.Results))
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newGroup(output.Results[i])
+	}
+	return
+}
+
+type GroupsGetResponse struct {
+	ListGetResponse
+
+	results []*Group
+}
+
+func (r *GroupsGetResponse) Results() []*Group {
+	return r.results
+}
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *GroupsGetResponse) NextPage() (response *GroupsGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(GroupsGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
+
+type GroupsPostRequest struct {
+	Request
+
+	input     data.GroupPostRequest
+	variables map[string]interface{}
+}
+
+// Name sets the name of the new group. It is mandatory.
+//
+func (r *GroupsPostRequest) Name(value string) *GroupsPostRequest {
+	r.input.Name = &value
+	return r
+}
+
+// Description sets the description of the new group.
+//
+func (r *GroupsPostRequest) Description(value string) *GroupsPostRequest {
+	r.input.Description = &value
+	return r
+}
+
+// Inventory sets the identifier of the inventory that the new group will belong to. It is
+// mandatory, unless the request is sent to the collection of groups of an inventory or to the
+// children of another group.
+//
+func (r *GroupsPostRequest) Inventory(value int) *GroupsPostRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+// Variables sets the complete map of group variables.
+//
+func (r *GroupsPostRequest) Variables(value map[string]interface{}) *GroupsPostRequest {
+	r.variables = value
+	return r
+}
+
+// Variable adds a single group variable.
+//
+func (r *GroupsPostRequest) Variable(name string, value interface{}) *GroupsPostRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *GroupsPostRequest) Send() (response *GroupsPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *GroupsPostRequest) SendContext(ctx context.Context) (response *GroupsPostResponse, err error) {
+	r.input.Variables, err = encodeVariables(r.variables)
+	if err != nil {
+		return
+	}
+	output := new(data.GroupPostResponse)
+	err = r.post(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(GroupsPostResponse)
+	response.result = newGroup(&output.Group)
+	return
+}
+
+type GroupsPostResponse struct {
+	result *Group
+}
+
+func (r *GroupsPostResponse) Result() *Group {
+	return r.result
+}
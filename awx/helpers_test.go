@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+// newTestConnection creates a connection to the given test server, authenticated with a fixed
+// bearer token. The connection is closed when the test finishes.
+func newTestConnection(t *testing.T, server *httptest.Server) *Connection {
+	t.Helper()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Bearer("token").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		connection.Close()
+	})
+	return connection
+}
+
+// recordedRequest contains the details of a request received by a recording server.
+type recordedRequest struct {
+	method string
+	path   string
+	query  url.Values
+	body   map[string]interface{}
+}
+
+// recordedResponse is the status code and body that a recording server sends for the requests with
+// a given method and path.
+type recordedResponse struct {
+	code int
+	body string
+}
+
+// recordingServer is a server that records the requests that it receives and responds to them
+// with the status codes and bodies configured with the respond method. Requests without a
+// configured response get an empty JSON object.
+type recordingServer struct {
+	*httptest.Server
+
+	lock      sync.Mutex
+	requests  []recordedRequest
+	responses map[string]recordedResponse
+}
+
+func newRecordingServer(t *testing.T) *recordingServer {
+	server := &recordingServer{
+		responses: make(map[string]recordedResponse),
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := recordedRequest{
+			method: r.Method,
+			path:   r.URL.Path,
+			query:  r.URL.Query(),
+		}
+		bytes, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		if len(bytes) > 0 {
+			err = json.Unmarshal(bytes, &request.body)
+			if err != nil {
+				t.Errorf("Can't decode body '%s': %v", bytes, err)
+			}
+		}
+		server.lock.Lock()
+		server.requests = append(server.requests, request)
+		response, ok := server.responses[r.Method+" "+r.URL.Path]
+		server.lock.Unlock()
+		if !ok {
+			response = recordedResponse{code: http.StatusOK, body: "{}"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(response.code)
+		fmt.Fprint(w, response.body)
+	}))
+	return server
+}
+
+// connect creates a connection to the server, authenticated with a fixed bearer token. The
+// connection is closed when the test finishes.
+func (s *recordingServer) connect(t *testing.T) *Connection {
+	t.Helper()
+	connection, err := NewConnectionBuilder().
+		URL(s.URL + "/api").
+		Bearer("token").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		connection.Close()
+	})
+	return connection
+}
+
+// respond configures the status code and body of the response to the requests with the given
+// method and path.
+func (s *recordingServer) respond(method, path string, code int, body string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.responses[method+" "+path] = recordedResponse{code: code, body: body}
+}
+
+// count returns the number of requests received with the given method and path.
+func (s *recordingServer) count(method, path string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	count := 0
+	for _, request := range s.requests {
+		if request.method == method && request.path == path {
+			count++
+		}
+	}
+	return count
+}
+
+// last returns the last request received with the given method and path, and fails the test if
+// there is no such request.
+func (s *recordingServer) last(t *testing.T, method, path string) recordedRequest {
+	t.Helper()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i := len(s.requests) - 1; i >= 0; i-- {
+		request := s.requests[i]
+		if request.method == method && request.path == path {
+			return request
+		}
+	}
+	t.Fatalf("Expected a '%s %s' request, got %v", method, path, s.requests)
+	return recordedRequest{}
+}
+
+// recorded returns a copy of the requests received so far.
+func (s *recordingServer) recorded() []recordedRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]recordedRequest(nil), s.requests...)
+}
+
+// decodeVariablesField decodes the given field of a request body, which contains variables
+// encoded as a JSON string, and fails the test if it can't be decoded.
+func decodeVariablesField(t *testing.T, body map[string]interface{}, field string) map[string]interface{} {
+	t.Helper()
+	text, ok := body[field].(string)
+	if !ok {
+		t.Fatalf("Expected field '%s' to be a string, got %v", field, body[field])
+	}
+	var variables map[string]interface{}
+	err := json.Unmarshal([]byte(text), &variables)
+	if err != nil {
+		t.Fatalf("Can't decode variables '%s': %v", text, err)
+	}
+	return variables
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the host type.
+
+package awx
+
+import (
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// Host represents an AWX host.
+//
+type Host struct {
+	id                  int
+	name                string
+	description         string
+	inventory           int
+	enabled             bool
+	instanceId          string
+	variables           map[string]interface{}
+	hasActiveFailures   bool
+	hasInventorySources bool
+	lastJob             int
+}
+
+// newHost creates a new host from the data returned by the server.
+//
+func newHost(output *data.Host) *Host {
+	host := new(Host)
+	host.id = output.Id
+	host.name = output.Name
+	host.description = output.Description
+	host.inventory = output.Inventory
+	host.enabled = output.Enabled
+	host.instanceId = output.InstanceId
+	host.variables = decodeVariables(output.Variables)
+	host.hasActiveFailures = output.HasActiveFailures
+	host.hasInventorySources = output.HasInventorySources
+	host.lastJob = output.LastJob
+	return host
+}
+
+// Id returns the unique identifier of the host.
+//
+func (h *Host) Id() int {
+	return h.id
+}
+
+// Name returns the name of the host, usually its DNS name or IP address.
+//
+func (h *Host) Name() string {
+	return h.name
+}
+
+// Description returns the description of the host.
+//
+func (h *Host) Description() string {
+	return h.description
+}
+
+// Inventory returns the identifier of the inventory that the host belongs to.
+//
+func (h *Host) Inventory() int {
+	return h.inventory
+}
+
+// Enabled returns true if the host is available and should be included in jobs.
+//
+func (h *Host) Enabled() bool {
+	return h.enabled
+}
+
+// InstanceId returns the identifier used by the remote inventory source for this host.
+//
+func (h *Host) InstanceId() string {
+	return h.instanceId
+}
+
+// Variables returns the host variables. It returns nil if the server stored them as YAML instead of
+// JSON, use the VariableData resource of the host to get them decoded by the server in that case.
+//
+func (h *Host) Variables() map[string]interface{} {
+	return h.variables
+}
+
+// HasActiveFailures returns true if the host failed in the last job.
+//
+func (h *Host) HasActiveFailures() bool {
+	return h.hasActiveFailures
+}
+
+// HasInventorySources returns true if the host was created by an external inventory source.
+//
+func (h *Host) HasInventorySources() bool {
+	return h.hasInventorySources
+}
+
+// LastJob returns the identifier of the last job that ran on the host.
+//
+func (h *Host) LastJob() int {
+	return h.lastJob
+}
@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a specific host.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type HostResource struct {
+	Resource
+}
+
+func NewHostResource(connection *Connection, path string) *HostResource {
+	resource := new(HostResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *HostResource) Get() *HostGetRequest {
+	request := new(HostGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *HostResource) Patch() *HostPatchRequest {
+	request := new(HostPatchRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *HostResource) Delete() *HostDeleteRequest {
+	request := new(HostDeleteRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// VariableData returns a reference to the resource that manages the variables of this host.
+//
+func (r *HostResource) VariableData() *VariableDataResource {
+	return NewVariableDataResource(r.connection, r.path+"/variable_data")
+}
+
+type HostGetRequest struct {
+	Request
+}
+
+func (r *HostGetRequest) Send() (response *HostGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *HostGetRequest) SendContext(ctx context.Context) (response *HostGetResponse, err error) {
+	output := new(data.HostGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(HostGetResponse)
+	response.result = newHost(&output.Host)
+	return
+}
+
+type HostGetResponse struct {
+	result *Host
+}
+
+func (r *HostGetResponse) Result() *Host {
+	return r.result
+}
+
+// HostPatchRequest modifies the host. Only the fields that are explicitly set are sent to the
+// server, the rest are left untouched. For example, to disable a host:
+//
+//	connection.Hosts().Id(42).Patch().Enabled(false).Send()
+//
+type HostPatchRequest struct {
+	Request
+
+	input     data.HostPatchRequest
+	variables map[string]interface{}
+}
+
+func (r *HostPatchRequest) Name(value string) *HostPatchRequest {
+	r.input.Name = &value
+	return r
+}
+
+func (r *HostPatchRequest) Description(value string) *HostPatchRequest {
+	r.input.Description = &value
+	return r
+}
+
+func (r *HostPatchRequest) Inventory(value int) *HostPatchRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+func (r *HostPatchRequest) Enabled(value bool) *HostPatchRequest {
+	r.input.Enabled = &value
+	return r
+}
+
+func (r *HostPatchRequest) InstanceId(value string) *HostPatchRequest {
+	r.input.InstanceId = &value
+	return r
+}
+
+// Variables replaces the complete map of host variables. To change only some of the variables
+// use the Patch request of the VariableData resource of the host.
+//
+func (r *HostPatchRequest) Variables(value map[string]interface{}) *HostPatchRequest {
+	r.variables = value
+	return r
+}
+
+func (r *HostPatchRequest) Variable(name string, value interface{}) *HostPatchRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *HostPatchRequest) Send() (response *HostPatchResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *HostPatchRequest) SendContext(ctx context.Context) (response *HostPatchResponse, err error) {
+	r.input.Variables, err = encodeVariables(r.variables)
+	if err != nil {
+		return
+	}
+	output := new(data.HostPatchResponse)
+	err = r.patch(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(HostPatchResponse)
+	response.result = newHost(&output.Host)
+	return
+}
+
+type HostPatchResponse struct {
+	result *Host
+}
+
+func (r *HostPatchResponse) Result() *Host {
+	return r.result
+}
+
+type HostDeleteRequest struct {
+	Request
+}
+
+func (r *HostDeleteRequest) Send() (response *HostDeleteResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *HostDeleteRequest) SendContext(ctx context.Context) (response *HostDeleteResponse, err error) {
+	err = r.delete(ctx)
+	if err != nil {
+		return
+	}
+	response = new(HostDeleteResponse)
+	return
+}
+
+type HostDeleteResponse struct {
+}
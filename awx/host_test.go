@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the hosts resources and the variable data resource.
+
+package awx
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestInventoryHostsGetFilter(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/inventories/3/hosts/", http.StatusOK, `{
+		"count": 2,
+		"results": [
+			{
+				"id": 7,
+				"name": "vm1.example.com",
+				"inventory": 3,
+				"enabled": true,
+				"variables": "{\"ansible_host\": \"10.0.0.1\"}"
+			},
+			{
+				"id": 8,
+				"name": "vm2.example.com",
+				"inventory": 3,
+				"enabled": false,
+				"variables": "ansible_host: 10.0.0.2"
+			}
+		]
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Id(3).Hosts().Get().
+		Filter("enabled", false).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := server.last(t, http.MethodGet, "/api/v2/inventories/3/hosts/").query
+	if query.Get("enabled") != "false" {
+		t.Errorf("Expected the enabled filter, got '%s'", query.Encode())
+	}
+	results := response.Results()
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 hosts, got %d", len(results))
+	}
+	host := results[0]
+	if host.Id() != 7 || host.Inventory() != 3 || !host.Enabled() {
+		t.Errorf("Unexpected host %+v", host)
+	}
+	expected := map[string]interface{}{
+		"ansible_host": "10.0.0.1",
+	}
+	if !reflect.DeepEqual(host.Variables(), expected) {
+		t.Errorf("Expected variables %v, got %v", expected, host.Variables())
+	}
+
+	// Variables stored as YAML can't be decoded, but that shouldn't affect the rest of the host:
+	host = results[1]
+	if host.Id() != 8 || host.Name() != "vm2.example.com" || host.Enabled() {
+		t.Errorf("Unexpected host %+v", host)
+	}
+	if host.Variables() != nil {
+		t.Errorf("Expected no variables, got %v", host.Variables())
+	}
+}
+
+func TestInventoryHostsPost(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/inventories/3/hosts/", http.StatusCreated, `{
+		"id": 7,
+		"name": "vm1.example.com",
+		"inventory": 3,
+		"enabled": true
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Id(3).Hosts().Post().
+		Name("vm1.example.com").
+		InstanceId("i-1234").
+		Variable("ansible_host", "10.0.0.1").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/inventories/3/hosts/").body
+	if body["name"] != "vm1.example.com" || body["instance_id"] != "i-1234" {
+		t.Errorf("Expected the name and instance identifier, got %v", body)
+	}
+	if _, ok := body["enabled"]; ok {
+		t.Errorf("Expected the enabled flag to be left to the server, got %v", body)
+	}
+	variables := decodeVariablesField(t, body, "variables")
+	if variables["ansible_host"] != "10.0.0.1" {
+		t.Errorf("Expected variable 'ansible_host' to be '10.0.0.1', got %v", variables)
+	}
+	host := response.Result()
+	if host.Id() != 7 || host.Inventory() != 3 || !host.Enabled() {
+		t.Errorf("Unexpected host %+v", host)
+	}
+}
+
+func TestHostPatchDisables(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPatch, "/api/v2/hosts/7/", http.StatusOK, `{
+		"id": 7,
+		"enabled": false
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Hosts().Id(7).Patch().
+		Enabled(false).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPatch, "/api/v2/hosts/7/").body
+	expected := map[string]interface{}{
+		"enabled": false,
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+	if response.Result().Enabled() {
+		t.Errorf("Expected the host to be disabled")
+	}
+}
+
+func TestInventoryHostsIdUsesTopLevelPath(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	connection := server.connect(t)
+
+	_, err := connection.Inventories().Id(3).Hosts().Id(7).Delete().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.count(http.MethodDelete, "/api/v2/hosts/7/") != 1 {
+		t.Errorf("Expected the host to be deleted using the top level path, got %v", server.recorded())
+	}
+}
+
+func TestVariableDataPatchMerges(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/hosts/7/variable_data/", http.StatusOK, `{
+		"ansible_host": "10.0.0.1",
+		"ansible_user": "root"
+	}`)
+	server.respond(http.MethodPut, "/api/v2/hosts/7/variable_data/", http.StatusOK, `{
+		"ansible_host": "10.0.0.2",
+		"ansible_user": "root",
+		"ansible_port": 2222
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Hosts().Id(7).VariableData().Patch().
+		Variable("ansible_host", "10.0.0.2").
+		Variable("ansible_port", 2222).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The variables that weren't changed must be sent back, as the server replaces all of them:
+	body := server.last(t, http.MethodPut, "/api/v2/hosts/7/variable_data/").body
+	expected := map[string]interface{}{
+		"ansible_host": "10.0.0.2",
+		"ansible_user": "root",
+		"ansible_port": 2222.0,
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+	if !reflect.DeepEqual(response.Variables(), expected) {
+		t.Errorf("Expected variables %v, got %v", expected, response.Variables())
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a collection of hosts,
+// either all the hosts or the hosts of an specific inventory.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type HostsResource struct {
+	Resource
+}
+
+func NewHostsResource(connection *Connection, path string) *HostsResource {
+	resource := new(HostsResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *HostsResource) Get() *HostsGetRequest {
+	request := new(HostsGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *HostsResource) Post() *HostsPostRequest {
+	request := new(HostsPostRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Id returns the resource that manages the host with the given identifier. Note that hosts are
+// always managed using the top level 'hosts' collection, even when this resource is the
+// collection of hosts of an inventory.
+//
+func (r *HostsResource) Id(id int) *HostResource {
+	return NewHostResource(r.connection, fmt.Sprintf("hosts/%d", id))
+}
+
+type HostsGetRequest struct {
+	Request
+}
+
+func (r *HostsGetRequest) Filter(name string, value interface{}) *HostsGetRequest {
+	r.addFilter(name, value)
+	return r
+}
+
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *HostsGetRequest) PageSize(value int) *HostsGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the hosts.
+// It stops and returns the error if the function returns an error.
+//
+func (r *HostsGetRequest) Each(f func(host *Host) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *HostsGetRequest) EachContext(ctx context.Context, f func(host *Host) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, host := range response.results {
+			err = f(host)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the hosts.
+//
+func (r *HostsGetRequest) All() (results []*Host, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *HostsGetRequest) AllContext(ctx context.Context) (results []*Host, err error) {
+	err = r.EachContext(ctx, func(host *Host) error {
+		results = append(results, host)
+		return nil
+	})
+	return
+}
+
+func (r *HostsGetRequest) Send() (response *HostsGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *HostsGetRequest) SendContext(ctx context.Context) (response *HostsGetResponse, err error) {
+	output := new(data.HostsGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(HostsGetResponse)
+	response.count = output.Count
+	response.previous = output.Previous
+	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
+	response.results = make([]*Host, len(output.Results))
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newHost(output.Results[i])
+	}
+	return
+}
+
+type HostsGetResponse struct {
+	ListGetResponse
+
+	results []*Host
+}
+
+func (r *HostsGetResponse) Results() []*Host {
+	return r.results
+}
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *HostsGetResponse) NextPage() (response *HostsGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(HostsGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
+
+type HostsPostRequest struct {
+	Request
+
+	input     data.HostPostRequest
+	variables map[string]interface{}
+}
+
+// Name sets the name of the new host. It is mandatory.
+//
+func (r *HostsPostRequest) Name(value string) *HostsPostRequest {
+	r.input.Name = &value
+	return r
+}
+
+// Description sets the description of the new host.
+//
+func (r *HostsPostRequest) Description(value string) *HostsPostRequest {
+	r.input.Description = &value
+	return r
+}
+
+// Inventory sets the identifier of the inventory that the new host will belong to. It is
+// mandatory, unless the request is sent to the collection of hosts of an inventory.
+//
+func (r *HostsPostRequest) Inventory(value int) *HostsPostRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+// Enabled sets the enabled flag of the new host. The server enables hosts by default.
+//
+func (r *HostsPostRequest) Enabled(value bool) *HostsPostRequest {
+	r.input.Enabled = &value
+	return r
+}
+
+// InstanceId sets the identifier used by remote inventory sources for the new host.
+//
+func (r *HostsPostRequest) InstanceId(value string) *HostsPostRequest {
+	r.input.InstanceId = &value
+	return r
+}
+
+// Variables sets the complete map of host variables.
+//
+func (r *HostsPostRequest) Variables(value map[string]interface{}) *HostsPostRequest {
+	r.variables = value
+	return r
+}
+
+// Variable adds a single host variable.
+//
+func (r *HostsPostRequest) Variable(name string, value interface{}) *HostsPostRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *HostsPostRequest) Send() (response *HostsPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *HostsPostRequest) SendContext(ctx context.Context) (response *HostsPostResponse, err error) {
+	r.input.Variables, err = encodeVariables(r.variables)
+	if err != nil {
+		return
+	}
+	output := new(data.HostPostResponse)
+	err = r.post(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(HostsPostResponse)
+	response.result = newHost(&output.Host)
+	return
+}
+
+type HostsPostResponse struct {
+	result *Host
+}
+
+func (r *HostsPostResponse) Result() *Host {
+	return r.result
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the data structures used for sending and receiving hosts.
+
+package data
+
+type Host struct {
+	Id                  int    `json:"id,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Description         string `json:"description,omitempty"`
+	Inventory           int    `json:"inventory,omitempty"`
+	Enabled             bool   `json:"enabled,omitempty"`
+	InstanceId          string `json:"instance_id,omitempty"`
+	Variables           string `json:"variables,omitempty"`
+	HasActiveFailures   bool   `json:"has_active_failures,omitempty"`
+	HasInventorySources bool   `json:"has_inventory_sources,omitempty"`
+	LastJob             int    `json:"last_job,omitempty"`
+}
+
+type HostGetResponse struct {
+	Host
+}
+
+// HostPostRequest contains the fields that can be set when creating or modifying a host. They are
+// pointers so that the fields that haven't been explicitly set aren't sent to the server.
+//
+type HostPostRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Inventory   *int    `json:"inventory,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+	InstanceId  *string `json:"instance_id,omitempty"`
+	Variables   *string `json:"variables,omitempty"`
+}
+
+type HostPostResponse struct {
+	Host
+}
+
+type HostPatchRequest struct {
+	HostPostRequest
+}
+
+type HostPatchResponse struct {
+	Host
+}
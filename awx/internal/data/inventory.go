@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the data structures used for sending and receiving inventories.
+
+package data
+
+type Inventory struct {
+	Id                           int    `json:"id,omitempty"`
+	Name                         string `json:"name,omitempty"`
+	Description                  string `json:"description,omitempty"`
+	Organization                 int    `json:"organization,omitempty"`
+	Kind                         string `json:"kind,omitempty"`
+	HostFilter                   string `json:"host_filter,omitempty"`
+	Variables                    string `json:"variables,omitempty"`
+	HasActiveFailures            bool   `json:"has_active_failures,omitempty"`
+	TotalHosts                   int    `json:"total_hosts,omitempty"`
+	HostsWithActiveFailures      int    `json:"hosts_with_active_failures,omitempty"`
+	TotalGroups                  int    `json:"total_groups,omitempty"`
+	HasInventorySources          bool   `json:"has_inventory_sources,omitempty"`
+	TotalInventorySources        int    `json:"total_inventory_sources,omitempty"`
+	InventorySourcesWithFailures int    `json:"inventory_sources_with_failures,omitempty"`
+}
+
+type InventoryGetResponse struct {
+	Inventory
+}
+
+// InventoryPostRequest contains the fields that can be set when creating or modifying an
+// inventory. They are pointers so that the fields that haven't been explicitly set aren't sent
+// to the server.
+//
+type InventoryPostRequest struct {
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Organization *int    `json:"organization,omitempty"`
+	Kind         *string `json:"kind,omitempty"`
+	HostFilter   *string `json:"host_filter,omitempty"`
+	Variables    *string `json:"variables,omitempty"`
+}
+
+type InventoryPostResponse struct {
+	Inventory
+}
+
+type InventoryPutRequest struct {
+	InventoryPostRequest
+}
+
+type InventoryPutResponse struct {
+	Inventory
+}
+
+type InventoryPatchRequest struct {
+	InventoryPostRequest
+}
+
+type InventoryPatchResponse struct {
+	Inventory
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the data structures used for sending and receiving inventory sources.
+
+package data
+
+import (
+	"time"
+)
+
+type InventorySource struct {
+	Id                 int       `json:"id,omitempty"`
+	Name               string    `json:"name,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	Inventory          int       `json:"inventory,omitempty"`
+	Source             string    `json:"source,omitempty"`
+	SourcePath         string    `json:"source_path,omitempty"`
+	SourceProject      int       `json:"source_project,omitempty"`
+	SourceVars         string    `json:"source_vars,omitempty"`
+	Credential         int       `json:"credential,omitempty"`
+	Overwrite          bool      `json:"overwrite,omitempty"`
+	OverwriteVars      bool      `json:"overwrite_vars,omitempty"`
+	UpdateOnLaunch     bool      `json:"update_on_launch,omitempty"`
+	UpdateCacheTimeout int       `json:"update_cache_timeout,omitempty"`
+	Status             string    `json:"status,omitempty"`
+	LastJobFailed      bool      `json:"last_job_failed,omitempty"`
+	LastUpdated        time.Time `json:"last_updated,omitempty"`
+}
+
+type InventorySourceGetResponse struct {
+	InventorySource
+}
+
+// InventorySourcePostRequest contains the fields that can be set when creating or modifying an
+// inventory source. They are pointers so that the fields that haven't been explicitly set aren't
+// sent to the server.
+//
+type InventorySourcePostRequest struct {
+	Name               *string `json:"name,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	Inventory          *int    `json:"inventory,omitempty"`
+	Source             *string `json:"source,omitempty"`
+	SourcePath         *string `json:"source_path,omitempty"`
+	SourceProject      *int    `json:"source_project,omitempty"`
+	SourceVars         *string `json:"source_vars,omitempty"`
+	Credential         *int    `json:"credential,omitempty"`
+	Overwrite          *bool   `json:"overwrite,omitempty"`
+	OverwriteVars      *bool   `json:"overwrite_vars,omitempty"`
+	UpdateOnLaunch     *bool   `json:"update_on_launch,omitempty"`
+	UpdateCacheTimeout *int    `json:"update_cache_timeout,omitempty"`
+}
+
+type InventorySourcePostResponse struct {
+	InventorySource
+}
+
+type InventorySourcePatchRequest struct {
+	InventorySourcePostRequest
+}
+
+type InventorySourcePatchResponse struct {
+	InventorySource
+}
+
+type InventorySourceUpdatePostResponse struct {
+	InventoryUpdate
+
+	InventoryUpdateId int `json:"inventory_update,omitempty"`
+}
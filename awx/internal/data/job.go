@@ -18,11 +18,54 @@ limitations under the License.
 
 package data
 
+import (
+	"time"
+)
+
 type Job struct {
-	Id     int    `json:"id,omitempty"`
-	Status string `json:"status,omitempty"`
+	Id                      int                    `json:"id,omitempty"`
+	Name                    string                 `json:"name,omitempty"`
+	Status                  string                 `json:"status,omitempty"`
+	JobTemplate             int                    `json:"job_template,omitempty"`
+	Inventory               int                    `json:"inventory,omitempty"`
+	Project                 int                    `json:"project,omitempty"`
+	Playbook                string                 `json:"playbook,omitempty"`
+	LaunchType              string                 `json:"launch_type,omitempty"`
+	Created                 time.Time              `json:"created,omitempty"`
+	Started                 time.Time              `json:"started,omitempty"`
+	Finished                time.Time              `json:"finished,omitempty"`
+	Elapsed                 float64                `json:"elapsed,omitempty"`
+	Failed                  bool                   `json:"failed,omitempty"`
+	JobExplanation          string                 `json:"job_explanation,omitempty"`
+	ResultTraceback         string                 `json:"result_traceback,omitempty"`
+	EventProcessingFinished *bool                  `json:"event_processing_finished,omitempty"`
+	Limit                   string                 `json:"limit,omitempty"`
+	ExtraVars               string                 `json:"extra_vars,omitempty"`
+	Artifacts               map[string]interface{} `json:"artifacts,omitempty"`
+	LaunchedBy              *JobLaunchedBy         `json:"launched_by,omitempty"`
+}
+
+type JobLaunchedBy struct {
+	Id   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
 }
 
 type JobGetResponse struct {
 	Job
 }
+
+type JobCancelGetResponse struct {
+	CanCancel bool `json:"can_cancel,omitempty"`
+}
+
+type JobRelaunchPostRequest struct {
+	Hosts               string            `json:"hosts,omitempty"`
+	CredentialPasswords map[string]string `json:"credential_passwords,omitempty"`
+}
+
+type JobRelaunchPostResponse struct {
+	Job
+
+	JobId int `json:"job,omitempty"`
+}
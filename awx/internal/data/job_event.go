@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the data structures used for receiving job events.
+
+package data
+
+import (
+	"time"
+)
+
+type JobEvent struct {
+	Id        int                    `json:"id,omitempty"`
+	Counter   int                    `json:"counter,omitempty"`
+	Event     string                 `json:"event,omitempty"`
+	Host      int                    `json:"host,omitempty"`
+	HostName  string                 `json:"host_name,omitempty"`
+	Task      string                 `json:"task,omitempty"`
+	Play      string                 `json:"play,omitempty"`
+	Role      string                 `json:"role,omitempty"`
+	Stdout    string                 `json:"stdout,omitempty"`
+	EventData map[string]interface{} `json:"event_data,omitempty"`
+	Failed    bool                   `json:"failed,omitempty"`
+	Changed   bool                   `json:"changed,omitempty"`
+	Created   time.Time              `json:"created,omitempty"`
+	Modified  time.Time              `json:"modified,omitempty"`
+}
+
+type JobEventsGetResponse struct {
+	ListGetResponse
+
+	Results []*JobEvent `json:"results,omitempty"`
+}
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the data structures used for receiving job host summaries.
+
+package data
+
+type JobHostSummary struct {
+	Id       int    `json:"id,omitempty"`
+	Host     int    `json:"host,omitempty"`
+	HostName string `json:"host_name,omitempty"`
+	Ok       int    `json:"ok,omitempty"`
+	Changed  int    `json:"changed,omitempty"`
+	Failures int    `json:"failures,omitempty"`
+	Dark     int    `json:"dark,omitempty"`
+	Skipped  int    `json:"skipped,omitempty"`
+	Rescued  int    `json:"rescued,omitempty"`
+	Ignored  int    `json:"ignored,omitempty"`
+	Failed   bool   `json:"failed,omitempty"`
+}
+
+type JobHostSummariesGetResponse struct {
+	ListGetResponse
+
+	Results []*JobHostSummary `json:"results,omitempty"`
+}
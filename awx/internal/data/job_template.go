@@ -18,13 +18,114 @@ limitations under the License.
 
 package data
 
+import (
+	"time"
+)
+
 type JobTemplate struct {
-	Id               int    `json:"id,omitempty"`
-	Name             string `json:"name,omitempty"`
-	AskLimitOnLaunch bool   `json:"ask_limit_on_launch,omitempty"`
-	AskVarsOnLaunch  bool   `json:"ask_variables_on_launch,omitempty"`
+	Id                              int       `json:"id,omitempty"`
+	Name                            string    `json:"name,omitempty"`
+	Description                     string    `json:"description,omitempty"`
+	JobType                         string    `json:"job_type,omitempty"`
+	Inventory                       int       `json:"inventory,omitempty"`
+	Project                         int       `json:"project,omitempty"`
+	Playbook                        string    `json:"playbook,omitempty"`
+	SCMBranch                       string    `json:"scm_branch,omitempty"`
+	Forks                           int       `json:"forks,omitempty"`
+	Limit                           string    `json:"limit,omitempty"`
+	Verbosity                       int       `json:"verbosity,omitempty"`
+	ExtraVars                       string    `json:"extra_vars,omitempty"`
+	JobTags                         string    `json:"job_tags,omitempty"`
+	SkipTags                        string    `json:"skip_tags,omitempty"`
+	StartAtTask                     string    `json:"start_at_task,omitempty"`
+	Timeout                         int       `json:"timeout,omitempty"`
+	UseFactCache                    bool      `json:"use_fact_cache,omitempty"`
+	HostConfigKey                   string    `json:"host_config_key,omitempty"`
+	DiffMode                        bool      `json:"diff_mode,omitempty"`
+	BecomeEnabled                   bool      `json:"become_enabled,omitempty"`
+	AllowSimultaneous               bool      `json:"allow_simultaneous,omitempty"`
+	SurveyEnabled                   bool      `json:"survey_enabled,omitempty"`
+	JobSliceCount                   int       `json:"job_slice_count,omitempty"`
+	ExecutionEnvironment            int       `json:"execution_environment,omitempty"`
+	AskSCMBranchOnLaunch            bool      `json:"ask_scm_branch_on_launch,omitempty"`
+	AskDiffModeOnLaunch             bool      `json:"ask_diff_mode_on_launch,omitempty"`
+	AskVarsOnLaunch                 bool      `json:"ask_variables_on_launch,omitempty"`
+	AskLimitOnLaunch                bool      `json:"ask_limit_on_launch,omitempty"`
+	AskTagsOnLaunch                 bool      `json:"ask_tags_on_launch,omitempty"`
+	AskSkipTagsOnLaunch             bool      `json:"ask_skip_tags_on_launch,omitempty"`
+	AskJobTypeOnLaunch              bool      `json:"ask_job_type_on_launch,omitempty"`
+	AskVerbosityOnLaunch            bool      `json:"ask_verbosity_on_launch,omitempty"`
+	AskInventoryOnLaunch            bool      `json:"ask_inventory_on_launch,omitempty"`
+	AskCredentialOnLaunch           bool      `json:"ask_credential_on_launch,omitempty"`
+	AskExecutionEnvironmentOnLaunch bool      `json:"ask_execution_environment_on_launch,omitempty"`
+	AskLabelsOnLaunch               bool      `json:"ask_labels_on_launch,omitempty"`
+	AskForksOnLaunch                bool      `json:"ask_forks_on_launch,omitempty"`
+	AskJobSliceCountOnLaunch        bool      `json:"ask_job_slice_count_on_launch,omitempty"`
+	AskTimeoutOnLaunch              bool      `json:"ask_timeout_on_launch,omitempty"`
+	AskInstanceGroupsOnLaunch       bool      `json:"ask_instance_groups_on_launch,omitempty"`
+	Status                          string    `json:"status,omitempty"`
+	LastJobRun                      time.Time `json:"last_job_run,omitempty"`
+	LastJobFailed                   bool      `json:"last_job_failed,omitempty"`
 }
 
 type JobTemplateGetResponse struct {
 	JobTemplate
 }
+
+// JobTemplatePostRequest contains the fields that can be set when creating or modifying a job
+// template. They are pointers so that the fields that haven't been explicitly set aren't sent to
+// the server.
+//
+type JobTemplatePostRequest struct {
+	Name                            *string `json:"name,omitempty"`
+	Description                     *string `json:"description,omitempty"`
+	JobType                         *string `json:"job_type,omitempty"`
+	Inventory                       *int    `json:"inventory,omitempty"`
+	Project                         *int    `json:"project,omitempty"`
+	Playbook                        *string `json:"playbook,omitempty"`
+	SCMBranch                       *string `json:"scm_branch,omitempty"`
+	Forks                           *int    `json:"forks,omitempty"`
+	Limit                           *string `json:"limit,omitempty"`
+	Verbosity                       *int    `json:"verbosity,omitempty"`
+	ExtraVars                       *string `json:"extra_vars,omitempty"`
+	JobTags                         *string `json:"job_tags,omitempty"`
+	SkipTags                        *string `json:"skip_tags,omitempty"`
+	StartAtTask                     *string `json:"start_at_task,omitempty"`
+	Timeout                         *int    `json:"timeout,omitempty"`
+	UseFactCache                    *bool   `json:"use_fact_cache,omitempty"`
+	HostConfigKey                   *string `json:"host_config_key,omitempty"`
+	DiffMode                        *bool   `json:"diff_mode,omitempty"`
+	BecomeEnabled                   *bool   `json:"become_enabled,omitempty"`
+	AllowSimultaneous               *bool   `json:"allow_simultaneous,omitempty"`
+	SurveyEnabled                   *bool   `json:"survey_enabled,omitempty"`
+	JobSliceCount                   *int    `json:"job_slice_count,omitempty"`
+	ExecutionEnvironment            *int    `json:"execution_environment,omitempty"`
+	AskSCMBranchOnLaunch            *bool   `json:"ask_scm_branch_on_launch,omitempty"`
+	AskDiffModeOnLaunch             *bool   `json:"ask_diff_mode_on_launch,omitempty"`
+	AskVarsOnLaunch                 *bool   `json:"ask_variables_on_launch,omitempty"`
+	AskLimitOnLaunch                *bool   `json:"ask_limit_on_launch,omitempty"`
+	AskTagsOnLaunch                 *bool   `json:"ask_tags_on_launch,omitempty"`
+	AskSkipTagsOnLaunch             *bool   `json:"ask_skip_tags_on_launch,omitempty"`
+	AskJobTypeOnLaunch              *bool   `json:"ask_job_type_on_launch,omitempty"`
+	AskVerbosityOnLaunch            *bool   `json:"ask_verbosity_on_launch,omitempty"`
+	AskInventoryOnLaunch            *bool   `json:"ask_inventory_on_launch,omitempty"`
+	AskCredentialOnLaunch           *bool   `json:"ask_credential_on_launch,omitempty"`
+	AskExecutionEnvironmentOnLaunch *bool   `json:"ask_execution_environment_on_launch,omitempty"`
+	AskLabelsOnLaunch               *bool   `json:"ask_labels_on_launch,omitempty"`
+	AskForksOnLaunch                *bool   `json:"ask_forks_on_launch,omitempty"`
+	AskJobSliceCountOnLaunch        *bool   `json:"ask_job_slice_count_on_launch,omitempty"`
+	AskTimeoutOnLaunch              *bool   `json:"ask_timeout_on_launch,omitempty"`
+	AskInstanceGroupsOnLaunch       *bool   `json:"ask_instance_groups_on_launch,omitempty"`
+}
+
+type JobTemplatePostResponse struct {
+	JobTemplate
+}
+
+type JobTemplatePatchRequest struct {
+	JobTemplatePostRequest
+}
+
+type JobTemplatePatchResponse struct {
+	JobTemplate
+}
@@ -19,12 +19,82 @@ limitations under the License.
 package data
 
 type JobTemplateLaunchGetResponse struct {
-	JobTemplateData *JobTemplateGetResponse `json:"job_template_data,omitempty"`
+	CanStartWithoutUserInput        bool                       `json:"can_start_without_user_input,omitempty"`
+	PasswordsNeededToStart          []string                   `json:"passwords_needed_to_start,omitempty"`
+	VariablesNeededToStart          []string                   `json:"variables_needed_to_start,omitempty"`
+	InventoryNeededToStart          bool                       `json:"inventory_needed_to_start,omitempty"`
+	CredentialNeededToStart         bool                       `json:"credential_needed_to_start,omitempty"`
+	SurveyEnabled                   bool                       `json:"survey_enabled,omitempty"`
+	AskSCMBranchOnLaunch            bool                       `json:"ask_scm_branch_on_launch,omitempty"`
+	AskDiffModeOnLaunch             bool                       `json:"ask_diff_mode_on_launch,omitempty"`
+	AskVarsOnLaunch                 bool                       `json:"ask_variables_on_launch,omitempty"`
+	AskLimitOnLaunch                bool                       `json:"ask_limit_on_launch,omitempty"`
+	AskTagsOnLaunch                 bool                       `json:"ask_tags_on_launch,omitempty"`
+	AskSkipTagsOnLaunch             bool                       `json:"ask_skip_tags_on_launch,omitempty"`
+	AskJobTypeOnLaunch              bool                       `json:"ask_job_type_on_launch,omitempty"`
+	AskVerbosityOnLaunch            bool                       `json:"ask_verbosity_on_launch,omitempty"`
+	AskInventoryOnLaunch            bool                       `json:"ask_inventory_on_launch,omitempty"`
+	AskCredentialOnLaunch           bool                       `json:"ask_credential_on_launch,omitempty"`
+	AskExecutionEnvironmentOnLaunch bool                       `json:"ask_execution_environment_on_launch,omitempty"`
+	AskLabelsOnLaunch               bool                       `json:"ask_labels_on_launch,omitempty"`
+	AskForksOnLaunch                bool                       `json:"ask_forks_on_launch,omitempty"`
+	AskJobSliceCountOnLaunch        bool                       `json:"ask_job_slice_count_on_launch,omitempty"`
+	AskTimeoutOnLaunch              bool                       `json:"ask_timeout_on_launch,omitempty"`
+	AskInstanceGroupsOnLaunch       bool                       `json:"ask_instance_groups_on_launch,omitempty"`
+	Defaults                        *JobTemplateLaunchDefaults `json:"defaults,omitempty"`
+	JobTemplateData                 *JobTemplateGetResponse    `json:"job_template_data,omitempty"`
 }
 
+// JobTemplateLaunchDefaults contains the values of the template that will be used for the fields
+// that aren't given when launching the job.
+//
+type JobTemplateLaunchDefaults struct {
+	ExtraVars   string                                 `json:"extra_vars,omitempty"`
+	Inventory   *JobTemplateLaunchDefaultsInventory    `json:"inventory,omitempty"`
+	Limit       string                                 `json:"limit,omitempty"`
+	JobTags     string                                 `json:"job_tags,omitempty"`
+	SkipTags    string                                 `json:"skip_tags,omitempty"`
+	JobType     string                                 `json:"job_type,omitempty"`
+	Verbosity   int                                    `json:"verbosity,omitempty"`
+	DiffMode    bool                                   `json:"diff_mode,omitempty"`
+	SCMBranch   string                                 `json:"scm_branch,omitempty"`
+	Credentials []*JobTemplateLaunchDefaultsCredential `json:"credentials,omitempty"`
+}
+
+type JobTemplateLaunchDefaultsInventory struct {
+	Id   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type JobTemplateLaunchDefaultsCredential struct {
+	Id              int      `json:"id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	PasswordsNeeded []string `json:"passwords_needed,omitempty"`
+}
+
+// JobTemplateLaunchPostRequest contains the values that can be given when launching a job. Most
+// of them are pointers so that the values that haven't been explicitly set aren't sent to the
+// server, and the ones of the template are used instead.
+//
 type JobTemplateLaunchPostRequest struct {
-	ExtraVars string `json:"extra_vars,omitempty"`
-	Limit     string `json:"limit,omitempty"`
+	ExtraVars            string  `json:"extra_vars,omitempty"`
+	Limit                string  `json:"limit,omitempty"`
+	Inventory            *int    `json:"inventory,omitempty"`
+	Credentials          []int   `json:"credentials,omitempty"`
+	JobType              *string `json:"job_type,omitempty"`
+	JobTags              *string `json:"job_tags,omitempty"`
+	SkipTags             *string `json:"skip_tags,omitempty"`
+	Verbosity            *int    `json:"verbosity,omitempty"`
+	DiffMode             *bool   `json:"diff_mode,omitempty"`
+	SCMBranch            *string `json:"scm_branch,omitempty"`
+	Forks                *int    `json:"forks,omitempty"`
+	Timeout              *int    `json:"timeout,omitempty"`
+	JobSliceCount        *int    `json:"job_slice_count,omitempty"`
+	ExecutionEnvironment *int    `json:"execution_environment,omitempty"`
+	Labels               []int   `json:"labels,omitempty"`
+	InstanceGroups       []int   `json:"instance_groups,omitempty"`
+
+	CredentialPasswords map[string]string `json:"credential_passwords,omitempty"`
 }
 
 type JobTemplateLaunchPostResponse struct {
@@ -30,6 +30,7 @@ type PATPostRequest struct {
 }
 
 type PATPostResponse struct {
+	Id      int       `json:"id,omitempty"`
 	Token   string    `json:"token,omitempty"`
 	Expires time.Time `json:"expires,omitempty"`
 }
@@ -18,14 +18,65 @@ limitations under the License.
 
 package data
 
+import (
+	"time"
+)
+
 type Project struct {
-	Id        int    `json:"id,omitempty"`
-	Name      string `json:"name,omitempty"`
-	SCMType   string `json:"scm_type,omitempty"`
-	SCMURL    string `json:"scm_url,omitempty"`
-	SCMBranch string `json:"scm_branch,omitempty"`
+	Id                    int       `json:"id,omitempty"`
+	Name                  string    `json:"name,omitempty"`
+	Description           string    `json:"description,omitempty"`
+	Organization          int       `json:"organization,omitempty"`
+	Credential            int       `json:"credential,omitempty"`
+	SCMType               string    `json:"scm_type,omitempty"`
+	SCMURL                string    `json:"scm_url,omitempty"`
+	SCMBranch             string    `json:"scm_branch,omitempty"`
+	SCMClean              bool      `json:"scm_clean,omitempty"`
+	SCMDeleteOnUpdate     bool      `json:"scm_delete_on_update,omitempty"`
+	SCMUpdateOnLaunch     bool      `json:"scm_update_on_launch,omitempty"`
+	SCMUpdateCacheTimeout int       `json:"scm_update_cache_timeout,omitempty"`
+	LocalPath             string    `json:"local_path,omitempty"`
+	Status                string    `json:"status,omitempty"`
+	LastUpdated           time.Time `json:"last_updated,omitempty"`
 }
 
 type ProjectGetResponse struct {
 	Project
 }
+
+// ProjectPostRequest contains the fields that can be set when creating or modifying a project.
+// They are pointers so that the fields that haven't been explicitly set aren't sent to the
+// server.
+//
+type ProjectPostRequest struct {
+	Name                  *string `json:"name,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	Organization          *int    `json:"organization,omitempty"`
+	Credential            *int    `json:"credential,omitempty"`
+	SCMType               *string `json:"scm_type,omitempty"`
+	SCMURL                *string `json:"scm_url,omitempty"`
+	SCMBranch             *string `json:"scm_branch,omitempty"`
+	SCMClean              *bool   `json:"scm_clean,omitempty"`
+	SCMDeleteOnUpdate     *bool   `json:"scm_delete_on_update,omitempty"`
+	SCMUpdateOnLaunch     *bool   `json:"scm_update_on_launch,omitempty"`
+	SCMUpdateCacheTimeout *int    `json:"scm_update_cache_timeout,omitempty"`
+	LocalPath             *string `json:"local_path,omitempty"`
+}
+
+type ProjectPostResponse struct {
+	Project
+}
+
+type ProjectPatchRequest struct {
+	ProjectPostRequest
+}
+
+type ProjectPatchResponse struct {
+	Project
+}
+
+type ProjectUpdatePostResponse struct {
+	ProjectUpdate
+
+	ProjectUpdateId int `json:"project_update,omitempty"`
+}
@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the data structures used to receive the standard output of jobs and
+// updates.
+
+package data
+
+type StdoutRange struct {
+	Start       int `json:"start,omitempty"`
+	End         int `json:"end,omitempty"`
+	AbsoluteEnd int `json:"absolute_end,omitempty"`
+}
+
+type StdoutGetResponse struct {
+	Range   StdoutRange `json:"range,omitempty"`
+	Content string      `json:"content,omitempty"`
+}
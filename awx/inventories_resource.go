@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages the collection of
+// inventories.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type InventoriesResource struct {
+	Resource
+}
+
+func NewInventoriesResource(connection *Connection, path string) *InventoriesResource {
+	resource := new(InventoriesResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *InventoriesResource) Get() *InventoriesGetRequest {
+	request := new(InventoriesGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *InventoriesResource) Post() *InventoriesPostRequest {
+	request := new(InventoriesPostRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *InventoriesResource) Id(id int) *InventoryResource {
+	return NewInventoryResource(r.connection, fmt.Sprintf("%s/%d", r.path, id))
+}
+
+type InventoriesGetRequest struct {
+	Request
+}
+
+func (r *InventoriesGetRequest) Filter(name string, value interface{}) *InventoriesGetRequest {
+	r.addFilter(name, value)
+	return r
+}
+
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *InventoriesGetRequest) PageSize(value int) *InventoriesGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the inventories.
+// It stops and returns the error if the function returns an error.
+//
+func (r *InventoriesGetRequest) Each(f func(inventory *Inventory) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *InventoriesGetRequest) EachContext(ctx context.Context, f func(inventory *Inventory) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, inventory := range response.results {
+			err = f(inventory)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the inventories.
+//
+func (r *InventoriesGetRequest) All() (results []*Inventory, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *InventoriesGetRequest) AllContext(ctx context.Context) (results []*Inventory, err error) {
+	err = r.EachContext(ctx, func(inventory *Inventory) error {
+		results = append(results, inventory)
+		return nil
+	})
+	return
+}
+
+func (r *InventoriesGetRequest) Send() (response *InventoriesGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventoriesGetRequest) SendContext(ctx context.Context) (response *InventoriesGetResponse, err error) {
+	output := new(data.InventoriesGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(InventoriesGetResponse)
+	response.count = output.Count
+	response.previous = output.Previous
+	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
+	response.results = make([]*Inventory, len(output.Results))
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newInventory(output.Results[i])
+	}
+	return
+}
+
+type InventoriesGetResponse struct {
+	ListGetResponse
+
+	results []*Inventory
+}
+
+func (r *InventoriesGetResponse) Results() []*Inventory {
+	return r.results
+}
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *InventoriesGetResponse) NextPage() (response *InventoriesGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(InventoriesGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
+
+type InventoriesPostRequest struct {
+	Request
+
+	input     data.InventoryPostRequest
+	variables map[string]interface{}
+}
+
+// Name sets the name of the new inventory. It is mandatory.
+//
+func (r *InventoriesPostRequest) Name(value string) *InventoriesPostRequest {
+	r.input.Name = &value
+	return r
+}
+
+// Description sets the description of the new inventory.
+//
+func (r *InventoriesPostRequest) Description(value string) *InventoriesPostRequest {
+	r.input.Description = &value
+	return r
+}
+
+// Organization sets the identifier of the organization that the new inventory will belong to. It
+// is mandatory.
+//
+func (r *InventoriesPostRequest) Organization(value int) *InventoriesPostRequest {
+	r.input.Organization = &value
+	return r
+}
+
+// Kind sets the kind of the new inventory. Smart inventories also need a host filter.
+//
+func (r *InventoriesPostRequest) Kind(value InventoryKind) *InventoriesPostRequest {
+	kind := string(value)
+	r.input.Kind = &kind
+	return r
+}
+
+// HostFilter sets the filter used to select the hosts of a smart inventory, for example
+// 'name__icontains=web'.
+//
+func (r *InventoriesPostRequest) HostFilter(value string) *InventoriesPostRequest {
+	r.input.HostFilter = &value
+	return r
+}
+
+// Variables sets the complete map of inventory variables.
+//
+func (r *InventoriesPostRequest) Variables(value map[string]interface{}) *InventoriesPostRequest {
+	r.variables = value
+	return r
+}
+
+// Variable adds a single inventory variable.
+//
+func (r *InventoriesPostRequest) Variable(name string, value interface{}) *InventoriesPostRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *InventoriesPostRequest) Send() (response *InventoriesPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventoriesPostRequest) SendContext(ctx context.Context) (response *InventoriesPostResponse, err error) {
+	r.input.Variables, err = encodeVariables(r.variables)
+	if err != nil {
+		return
+	}
+	output := new(data.InventoryPostResponse)
+	err = r.post(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(InventoriesPostResponse)
+	response.result = newInventory(&output.Inventory)
+	return
+}
+
+type InventoriesPostResponse struct {
+	result *Inventory
+}
+
+func (r *InventoriesPostResponse) Result() *Inventory {
+	return r.result
+}
@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the inventory type.
+
+package awx
+
+import (
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type InventoryKind string
+
+const (
+	InventoryKindRegular InventoryKind = ""
+	InventoryKindSmart   InventoryKind = "smart"
+)
+
+// Inventory represents an AWX inventory.
+//
+type Inventory struct {
+	id                           int
+	name                         string
+	description                  string
+	organization                 int
+	kind                         InventoryKind
+	hostFilter                   string
+	variables                    map[string]interface{}
+	hasActiveFailures            bool
+	totalHosts                   int
+	hostsWithActiveFailures      int
+	totalGroups                  int
+	hasInventorySources          bool
+	totalInventorySources        int
+	inventorySourcesWithFailures int
+}
+
+// newInventory creates a new inventory from the data returned by the server.
+//
+func newInventory(output *data.Inventory) *Inventory {
+	inventory := new(Inventory)
+	inventory.id = output.Id
+	inventory.name = output.Name
+	inventory.description = output.Description
+	inventory.organization = output.Organization
+	inventory.kind = (InventoryKind)(output.Kind)
+	inventory.hostFilter = output.HostFilter
+	inventory.variables = decodeVariables(output.Variables)
+	inventory.hasActiveFailures = output.HasActiveFailures
+	inventory.totalHosts = output.TotalHosts
+	inventory.hostsWithActiveFailures = output.HostsWithActiveFailures
+	inventory.totalGroups = output.TotalGroups
+	inventory.hasInventorySources = output.HasInventorySources
+	inventory.totalInventorySources = output.TotalInventorySources
+	inventory.inventorySourcesWithFailures = output.InventorySourcesWithFailures
+	return inventory
+}
+
+// Id returns the unique identifier of the inventory.
+//
+func (i *Inventory) Id() int {
+	return i.id
+}
+
+// Name returns the name of the inventory.
+//
+func (i *Inventory) Name() string {
+	return i.name
+}
+
+// Description returns the description of the inventory.
+//
+func (i *Inventory) Description() string {
+	return i.description
+}
+
+// Organization returns the identifier of the organization that the inventory belongs to.
+//
+func (i *Inventory) Organization() int {
+	return i.organization
+}
+
+// Kind returns the kind of the inventory, either regular or smart.
+//
+func (i *Inventory) Kind() InventoryKind {
+	return i.kind
+}
+
+// HostFilter returns the filter used to select the hosts of a smart inventory.
+//
+func (i *Inventory) HostFilter() string {
+	return i.hostFilter
+}
+
+// Variables returns the inventory variables. It returns nil if the server stored them as YAML
+// instead of JSON, use the VariableData resource of the inventory to get them decoded by the server
+// in that case.
+//
+func (i *Inventory) Variables() map[string]interface{} {
+	return i.variables
+}
+
+// HasActiveFailures returns true if any of the hosts of the inventory has failed in the last job.
+//
+func (i *Inventory) HasActiveFailures() bool {
+	return i.hasActiveFailures
+}
+
+// TotalHosts returns the number of hosts in the inventory.
+//
+func (i *Inventory) TotalHosts() int {
+	return i.totalHosts
+}
+
+// HostsWithActiveFailures returns the number of hosts of the inventory that failed in the last
+// job.
+//
+func (i *Inventory) HostsWithActiveFailures() int {
+	return i.hostsWithActiveFailures
+}
+
+// TotalGroups returns the number of groups in the inventory.
+//
+func (i *Inventory) TotalGroups() int {
+	return i.totalGroups
+}
+
+// HasInventorySources returns true if the inventory has external inventory sources.
+//
+func (i *Inventory) HasInventorySources() bool {
+	return i.hasInventorySources
+}
+
+// TotalInventorySources returns the number of external inventory sources of the inventory.
+//
+func (i *Inventory) TotalInventorySources() int {
+	return i.totalInventorySources
+}
+
+// InventorySourcesWithFailures returns the number of inventory sources that failed to update.
+//
+func (i *Inventory) InventorySourcesWithFailures() int {
+	return i.inventorySourcesWithFailures
+}
@@ -0,0 +1,287 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a specific inventory.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type InventoryResource struct {
+	Resource
+}
+
+func NewInventoryResource(connection *Connection, path string) *InventoryResource {
+	resource := new(InventoryResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *InventoryResource) Get() *InventoryGetRequest {
+	request := new(InventoryGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *InventoryResource) Put() *InventoryPutRequest {
+	request := new(InventoryPutRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *InventoryResource) Patch() *InventoryPatchRequest {
+	request := new(InventoryPatchRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *InventoryResource) Delete() *InventoryDeleteRequest {
+	request := new(InventoryDeleteRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Groups returns a reference to the resource that manages the collection of groups of this
+// inventory.
+//
+func (r *InventoryResource) Groups() *GroupsResource {
+	return NewGroupsResource(r.connection, r.path+"/groups")
+}
+
+// Hosts returns a reference to the resource that manages the collection of hosts of this
+// inventory. Individual hosts obtained with its Id method are managed using the top level 'hosts'
+// collection.
+//
+func (r *InventoryResource) Hosts() *HostsResource {
+	return NewHostsResource(r.connection, r.path+"/hosts")
+}
+
+// InventorySources returns a reference to the resource that manages the collection of sources of
+// this inventory.
+//
+func (r *InventoryResource) InventorySources() *InventorySourcesResource {
+	return NewInventorySourcesResource(r.connection, r.path+"/inventory_sources")
+}
+
+// VariableData returns a reference to the resource that manages the variables of this inventory.
+//
+func (r *InventoryResource) VariableData() *VariableDataResource {
+	return NewVariableDataResource(r.connection, r.path+"/variable_data")
+}
+
+type InventoryGetRequest struct {
+	Request
+}
+
+func (r *InventoryGetRequest) Send() (response *InventoryGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventoryGetRequest) SendContext(ctx context.Context) (response *InventoryGetResponse, err error) {
+	output := new(data.InventoryGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(InventoryGetResponse)
+	response.result = newInventory(&output.Inventory)
+	return
+}
+
+type InventoryGetResponse struct {
+	result *Inventory
+}
+
+func (r *InventoryGetResponse) Result() *Inventory {
+	return r.result
+}
+
+// InventoryPutRequest replaces the inventory. Fields that aren't set are reset to their default
+// values by the server, so the name and the organization must always be provided.
+//
+type InventoryPutRequest struct {
+	Request
+
+	input     data.InventoryPutRequest
+	variables map[string]interface{}
+}
+
+func (r *InventoryPutRequest) Name(value string) *InventoryPutRequest {
+	r.input.Name = &value
+	return r
+}
+
+func (r *InventoryPutRequest) Description(value string) *InventoryPutRequest {
+	r.input.Description = &value
+	return r
+}
+
+func (r *InventoryPutRequest) Organization(value int) *InventoryPutRequest {
+	r.input.Organization = &value
+	return r
+}
+
+func (r *InventoryPutRequest) Kind(value InventoryKind) *InventoryPutRequest {
+	kind := string(value)
+	r.input.Kind = &kind
+	return r
+}
+
+func (r *InventoryPutRequest) HostFilter(value string) *InventoryPutRequest {
+	r.input.HostFilter = &value
+	return r
+}
+
+func (r *InventoryPutRequest) Variables(value map[string]interface{}) *InventoryPutRequest {
+	r.variables = value
+	return r
+}
+
+func (r *InventoryPutRequest) Variable(name string, value interface{}) *InventoryPutRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *InventoryPutRequest) Send() (response *InventoryPutResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventoryPutRequest) SendContext(ctx context.Context) (response *InventoryPutResponse, err error) {
+	r.input.Variables, err = encodeVariables(r.variables)
+	if err != nil {
+		return
+	}
+	output := new(data.InventoryPutResponse)
+	err = r.put(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(InventoryPutResponse)
+	response.result = newInventory(&output.Inventory)
+	return
+}
+
+type InventoryPutResponse struct {
+	result *Inventory
+}
+
+func (r *InventoryPutResponse) Result() *Inventory {
+	return r.result
+}
+
+// InventoryPatchRequest modifies the inventory. Only the fields that are explicitly set are sent
+// to the server, the rest are left untouched.
+//
+type InventoryPatchRequest struct {
+	Request
+
+	input     data.InventoryPatchRequest
+	variables map[string]interface{}
+}
+
+func (r *InventoryPatchRequest) Name(value string) *InventoryPatchRequest {
+	r.input.Name = &value
+	return r
+}
+
+func (r *InventoryPatchRequest) Description(value string) *InventoryPatchRequest {
+	r.input.Description = &value
+	return r
+}
+
+func (r *InventoryPatchRequest) Organization(value int) *InventoryPatchRequest {
+	r.input.Organization = &value
+	return r
+}
+
+func (r *InventoryPatchRequest) Kind(value InventoryKind) *InventoryPatchRequest {
+	kind := string(value)
+	r.input.Kind = &kind
+	return r
+}
+
+func (r *InventoryPatchRequest) HostFilter(value string) *InventoryPatchRequest {
+	r.input.HostFilter = &value
+	return r
+}
+
+func (r *InventoryPatchRequest) Variables(value map[string]interface{}) *InventoryPatchRequest {
+	r.variables = value
+	return r
+}
+
+func (r *InventoryPatchRequest) Variable(name string, value interface{}) *InventoryPatchRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *InventoryPatchRequest) Send() (response *InventoryPatchResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventoryPatchRequest) SendContext(ctx context.Context) (response *InventoryPatchResponse, err error) {
+	r.input.Variables, err = encodeVariables(r.variables)
+	if err != nil {
+		return
+	}
+	output := new(data.InventoryPatchResponse)
+	err = r.patch(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(InventoryPatchResponse)
+	response.result = newInventory(&output.Inventory)
+	return
+}
+
+type InventoryPatchResponse struct {
+	result *Inventory
+}
+
+func (r *InventoryPatchResponse) Result() *Inventory {
+	return r.result
+}
+
+type InventoryDeleteRequest struct {
+	Request
+}
+
+func (r *InventoryDeleteRequest) Send() (response *InventoryDeleteResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventoryDeleteRequest) SendContext(ctx context.Context) (response *InventoryDeleteResponse, err error) {
+	err = r.delete(ctx)
+	if err != nil {
+		return
+	}
+	response = new(InventoryDeleteResponse)
+	return
+}
+
+type InventoryDeleteResponse struct {
+}
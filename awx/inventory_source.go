@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the inventory source type.
+
+package awx
+
+import (
+	"time"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// InventorySource represents an external source of hosts and groups for an inventory, for example
+// a cloud provider or a script in a project.
+//
+type InventorySource struct {
+	id                 int
+	name               string
+	description        string
+	inventory          int
+	source             string
+	sourcePath         string
+	sourceProject      int
+	sourceVars         string
+	credential         int
+	overwrite          bool
+	overwriteVars      bool
+	updateOnLaunch     bool
+	updateCacheTimeout int
+	status             JobStatus
+	lastJobFailed      bool
+	lastUpdated        time.Time
+}
+
+// newInventorySource creates a new inventory source from the data returned by the server.
+//
+func newInventorySource(output *data.InventorySource) *InventorySource {
+	source := new(InventorySource)
+	source.id = output.Id
+	source.name = output.Name
+	source.description = output.Description
+	source.inventory = output.Inventory
+	source.source = output.Source
+	source.sourcePath = output.SourcePath
+	source.sourceProject = output.SourceProject
+	source.sourceVars = output.SourceVars
+	source.credential = output.Credential
+	source.overwrite = output.Overwrite
+	source.overwriteVars = output.OverwriteVars
+	source.updateOnLaunch = output.UpdateOnLaunch
+	source.updateCacheTimeout = output.UpdateCacheTimeout
+	source.status = (JobStatus)(output.Status)
+	source.lastJobFailed = output.LastJobFailed
+	source.lastUpdated = output.LastUpdated
+	return source
+}
+
+// Id returns the unique identifier of the inventory source.
+//
+func (s *InventorySource) Id() int {
+	return s.id
+}
+
+// Name returns the name of the inventory source.
+//
+func (s *InventorySource) Name() string {
+	return s.name
+}
+
+// Description returns the description of the inventory source.
+//
+func (s *InventorySource) Description() string {
+	return s.description
+}
+
+// Inventory returns the identifier of the inventory that the source populates.
+//
+func (s *InventorySource) Inventory() int {
+	return s.inventory
+}
+
+// Source returns the type of the source, for example 'scm', 'ec2' or 'vmware'.
+//
+func (s *InventorySource) Source() string {
+	return s.source
+}
+
+// SourcePath returns the path of the inventory file inside the project, for 'scm' sources.
+//
+func (s *InventorySource) SourcePath() string {
+	return s.sourcePath
+}
+
+// SourceProject returns the identifier of the project that contains the inventory file, for
+// 'scm' sources.
+//
+func (s *InventorySource) SourceProject() int {
+	return s.sourceProject
+}
+
+// SourceVars returns the variables passed to the inventory plugin or script, as the YAML or JSON
+// text stored by the server.
+//
+func (s *InventorySource) SourceVars() string {
+	return s.sourceVars
+}
+
+// Credential returns the identifier of the credential used to access the source.
+//
+func (s *InventorySource) Credential() int {
+	return s.credential
+}
+
+// Overwrite returns true if hosts and groups that no longer exist in the source are removed from
+// the inventory when it is updated.
+//
+func (s *InventorySource) Overwrite() bool {
+	return s.overwrite
+}
+
+// OverwriteVars returns true if the variables in the inventory are replaced by the ones of the
+// source when it is updated, instead of merged.
+//
+func (s *InventorySource) OverwriteVars() bool {
+	return s.overwriteVars
+}
+
+// UpdateOnLaunch returns true if the source is updated each time a job using the inventory is
+// launched.
+//
+func (s *InventorySource) UpdateOnLaunch() bool {
+	return s.updateOnLaunch
+}
+
+// UpdateCacheTimeout returns the number of seconds that the result of an update is considered
+// current when UpdateOnLaunch is true.
+//
+func (s *InventorySource) UpdateCacheTimeout() int {
+	return s.updateCacheTimeout
+}
+
+// Status returns the status of the last update of the source.
+//
+func (s *InventorySource) Status() JobStatus {
+	return s.status
+}
+
+// LastJobFailed returns true if the last update of the source failed.
+//
+func (s *InventorySource) LastJobFailed() bool {
+	return s.lastJobFailed
+}
+
+// LastUpdated returns the time of the last update of the source.
+//
+func (s *InventorySource) LastUpdated() time.Time {
+	return s.lastUpdated
+}
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a specific inventory
+// source.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type InventorySourceResource struct {
+	Resource
+}
+
+func NewInventorySourceResource(connection *Connection, path string) *InventorySourceResource {
+	resource := new(InventorySourceResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *InventorySourceResource) Get() *InventorySourceGetRequest {
+	request := new(InventorySourceGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *InventorySourceResource) Patch() *InventorySourcePatchRequest {
+	request := new(InventorySourcePatchRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *InventorySourceResource) Delete() *InventorySourceDeleteRequest {
+	request := new(InventorySourceDeleteRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Update returns a request that starts an update of the inventory from this source. The update
+// runs asynchronously, use the InventoryUpdates resource of the connection to check its status.
+//
+func (r *InventorySourceResource) Update() *InventorySourceUpdatePostRequest {
+	request := new(InventorySourceUpdatePostRequest)
+	request.resource = &Resource{
+		connection: r.connection,
+		path:       r.path + "/update",
+	}
+	return request
+}
+
+// InventoryUpdates returns a reference to the resource that manages the collection of updates of
+// this inventory source.
+//
+func (r *InventorySourceResource) InventoryUpdates() *InventoryUpdatesResource {
+	return NewInventoryUpdatesResource(r.connection, r.path+"/inventory_updates")
+}
+
+type InventorySourceGetRequest struct {
+	Request
+}
+
+func (r *InventorySourceGetRequest) Send() (response *InventorySourceGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventorySourceGetRequest) SendContext(ctx context.Context) (response *InventorySourceGetResponse, err error) {
+	output := new(data.InventorySourceGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(InventorySourceGetResponse)
+	response.result = newInventorySource(&output.InventorySource)
+	return
+}
+
+type InventorySourceGetResponse struct {
+	result *InventorySource
+}
+
+func (r *InventorySourceGetResponse) Result() *InventorySource {
+	return r.result
+}
+
+// InventorySourcePatchRequest modifies the inventory source. Only the fields that are explicitly
+// set are sent to the server, the rest are left untouched.
+//
+type InventorySourcePatchRequest struct {
+	Request
+
+	input data.InventorySourcePatchRequest
+}
+
+func (r *InventorySourcePatchRequest) Name(value string) *InventorySourcePatchRequest {
+	r.input.Name = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) Description(value string) *InventorySourcePatchRequest {
+	r.input.Description = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) Inventory(value int) *InventorySourcePatchRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) Source(value string) *InventorySourcePatchRequest {
+	r.input.Source = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) SourcePath(value string) *InventorySourcePatchRequest {
+	r.input.SourcePath = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) SourceProject(value int) *InventorySourcePatchRequest {
+	r.input.SourceProject = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) SourceVars(value string) *InventorySourcePatchRequest {
+	r.input.SourceVars = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) Credential(value int) *InventorySourcePatchRequest {
+	r.input.Credential = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) Overwrite(value bool) *InventorySourcePatchRequest {
+	r.input.Overwrite = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) OverwriteVars(value bool) *InventorySourcePatchRequest {
+	r.input.OverwriteVars = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) UpdateOnLaunch(value bool) *InventorySourcePatchRequest {
+	r.input.UpdateOnLaunch = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) UpdateCacheTimeout(value int) *InventorySourcePatchRequest {
+	r.input.UpdateCacheTimeout = &value
+	return r
+}
+
+func (r *InventorySourcePatchRequest) Send() (response *InventorySourcePatchResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventorySourcePatchRequest) SendContext(ctx context.Context) (response *InventorySourcePatchResponse, err error) {
+	output := new(data.InventorySourcePatchResponse)
+	err = r.patch(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(InventorySourcePatchResponse)
+	response.result = newInventorySource(&output.InventorySource)
+	return
+}
+
+type InventorySourcePatchResponse struct {
+	result *InventorySource
+}
+
+func (r *InventorySourcePatchResponse) Result() *InventorySource {
+	return r.result
+}
+
+type InventorySourceDeleteRequest struct {
+	Request
+}
+
+func (r *InventorySourceDeleteRequest) Send() (response *InventorySourceDeleteResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventorySourceDeleteRequest) SendContext(ctx context.Context) (response *InventorySourceDeleteResponse, err error) {
+	err = r.delete(ctx)
+	if err != nil {
+		return
+	}
+	response = new(InventorySourceDeleteResponse)
+	return
+}
+
+type InventorySourceDeleteResponse struct {
+}
+
+type InventorySourceUpdatePostRequest struct {
+	Request
+}
+
+func (r *InventorySourceUpdatePostRequest) Send() (response *InventorySourceUpdatePostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventorySourceUpdatePostRequest) SendContext(ctx context.Context) (response *InventorySourceUpdatePostResponse, err error) {
+	output := new(data.InventorySourceUpdatePostResponse)
+	err = r.post(ctx, struct{}{}, output)
+	if err != nil {
+		return
+	}
+	response = new(InventorySourceUpdatePostResponse)
+	response.result = newInventoryUpdate(&output.InventoryUpdate)
+	if output.InventoryUpdateId != 0 {
+		response.result.id = output.InventoryUpdateId
+	}
+	return
+}
+
+type InventorySourceUpdatePostResponse struct {
+	result *InventoryUpdate
+}
+
+// InventoryUpdate returns the inventory update that has been started.
+//
+func (r *InventorySourceUpdatePostResponse) InventoryUpdate() *InventoryUpdate {
+	return r.result
+}
@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the inventory sources and inventory updates resources.
+
+package awx
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestInventorySourcesPost(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/inventories/3/inventory_sources/", http.StatusCreated, `{
+		"id": 9,
+		"name": "mysource",
+		"inventory": 3,
+		"source": "scm",
+		"source_project": 4,
+		"source_path": "hosts.ini",
+		"overwrite": true,
+		"status": "never updated"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Id(3).InventorySources().Post().
+		Name("mysource").
+		Source("scm").
+		SourceProject(4).
+		SourcePath("hosts.ini").
+		Overwrite(true).
+		UpdateOnLaunch(false).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/inventories/3/inventory_sources/").body
+	if body["source"] != "scm" || body["source_project"] != 4.0 || body["source_path"] != "hosts.ini" {
+		t.Errorf("Expected the source type, project and path, got %v", body)
+	}
+	if body["overwrite"] != true {
+		t.Errorf("Expected the overwrite flag to be true, got %v", body)
+	}
+
+	// Flags explicitly set to false must be sent, as the server may have a different default:
+	if body["update_on_launch"] != false {
+		t.Errorf("Expected the update on launch flag to be false, got %v", body)
+	}
+	if _, ok := body["overwrite_vars"]; ok {
+		t.Errorf("Expected no overwrite variables flag, got %v", body)
+	}
+	source := response.Result()
+	if source.Id() != 9 || source.Inventory() != 3 || source.SourceProject() != 4 || !source.Overwrite() {
+		t.Errorf("Unexpected inventory source %+v", source)
+	}
+}
+
+func TestInventorySourcePatchSendsOnlyChanges(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPatch, "/api/v2/inventory_sources/9/", http.StatusOK, `{
+		"id": 9,
+		"update_on_launch": true
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.InventorySources().Id(9).Patch().
+		UpdateOnLaunch(true).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPatch, "/api/v2/inventory_sources/9/").body
+	expected := map[string]interface{}{
+		"update_on_launch": true,
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+	if !response.Result().UpdateOnLaunch() {
+		t.Errorf("Expected the update on launch flag to be true")
+	}
+}
+
+func TestInventorySourceUpdate(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/inventory_sources/9/update/", http.StatusAccepted, `{
+		"inventory_update": 12,
+		"status": "pending"
+	}`)
+	server.respond(http.MethodGet, "/api/v2/inventory_updates/12/", http.StatusOK, `{
+		"id": 12,
+		"status": "successful",
+		"inventory_source": 9
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.InventorySources().Id(9).Update().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := response.InventoryUpdate()
+	if update.Id() != 12 || update.Status() != JobStatusPending || update.IsFinished() {
+		t.Errorf("Unexpected inventory update %+v", update)
+	}
+
+	// The update can be polled like a job, till it finishes:
+	getResponse, err := connection.InventoryUpdates().Id(update.Id()).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	update = getResponse.Result()
+	if !update.IsFinished() || !update.IsSuccessful() || update.InventorySource() != 9 {
+		t.Errorf("Unexpected inventory update %+v", update)
+	}
+	if server.count(http.MethodGet, "/api/v2/inventory_updates/12/") != 1 {
+		t.Errorf("Expected the update to be retrieved, got %v", server.recorded())
+	}
+}
+
+func TestInventorySourceInventoryUpdatesFilter(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/inventory_sources/9/inventory_updates/", http.StatusOK, `{
+		"count": 1,
+		"results": [{
+			"id": 11,
+			"status": "failed",
+			"inventory_source": 9
+		}]
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.InventorySources().Id(9).InventoryUpdates().Get().
+		Filter("status", "failed").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := server.last(t, http.MethodGet, "/api/v2/inventory_sources/9/inventory_updates/").query
+	if query.Get("status") != "failed" {
+		t.Errorf("Expected the status filter, got '%s'", query.Encode())
+	}
+	results := response.Results()
+	if len(results) != 1 || results[0].Id() != 11 || results[0].IsSuccessful() {
+		t.Errorf("Unexpected inventory updates %v", results)
+	}
+}
+
+func TestInventorySourceUpdateNotAllowed(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(
+		http.MethodPost, "/api/v2/inventory_sources/9/update/", http.StatusMethodNotAllowed,
+		`{"detail": "Method \"POST\" not allowed."}`,
+	)
+	connection := server.connect(t)
+
+	response, err := connection.InventorySources().Id(9).Update().Send()
+	if err == nil {
+		t.Errorf("Expected an error, got inventory update %+v", response.InventoryUpdate())
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a collection of inventory
+// sources, either all of them or the sources of an specific inventory.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type InventorySourcesResource struct {
+	Resource
+}
+
+func NewInventorySourcesResource(connection *Connection, path string) *InventorySourcesResource {
+	resource := new(InventorySourcesResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *InventorySourcesResource) Get() *InventorySourcesGetRequest {
+	request := new(InventorySourcesGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *InventorySourcesResource) Post() *InventorySourcesPostRequest {
+	request := new(InventorySourcesPostRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Id returns the resource that manages the inventory source with the given identifier. Note that
+// inventory sources are always managed using the top level 'inventory_sources' collection, even
+// when this resource is the collection of sources of an inventory.
+//
+func (r *InventorySourcesResource) Id(id int) *InventorySourceResource {
+	return NewInventorySourceResource(r.connection, fmt.Sprintf("inventory_sources/%d", id))
+}
+
+type InventorySourcesGetRequest struct {
+	Request
+}
+
+func (r *InventorySourcesGetRequest) Filter(name string, value interface{}) *InventorySourcesGetRequest {
+	r.addFilter(name, value)
+	return r
+}
+
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *InventorySourcesGetRequest) PageSize(value int) *InventorySourcesGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the inventory sources.
+// It stops and returns the error if the function returns an error.
+//
+func (r *InventorySourcesGetRequest) Each(f func(inventorySource *InventorySource) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *InventorySourcesGetRequest) EachContext(ctx context.Context, f func(inventorySource *InventorySource) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, inventorySource := range response.results {
+			err = f(inventorySource)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the inventory sources.
+//
+func (r *InventorySourcesGetRequest) All() (results []*InventorySource, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *InventorySourcesGetRequest) AllContext(ctx context.Context) (results []*InventorySource, err error) {
+	err = r.EachContext(ctx, func(inventorySource *InventorySource) error {
+		results = append(results, inventorySource)
+		return nil
+	})
+	return
+}
+
+func (r *InventorySourcesGetRequest) Send() (response *InventorySourcesGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventorySourcesGetRequest) SendContext(ctx context.Context) (response *InventorySourcesGetResponse, err error) {
+	output := new(data.InventorySourcesGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(InventorySourcesGetResponse)
+	response.count = output.Count
+	response.previous = output.Previous
+	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
+	response.results = make([]*InventorySource, len(output.Results))
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newInventorySource(output.Results[i])
+	}
+	return
+}
+
+type InventorySourcesGetResponse struct {
+	ListGetResponse
+
+	results []*InventorySource
+}
+
+func (r *InventorySourcesGetResponse) Results() []*InventorySource {
+	return r.results
+}
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *InventorySourcesGetResponse) NextPage() (response *InventorySourcesGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(InventorySourcesGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
+
+type InventorySourcesPostRequest struct {
+	Request
+
+	input data.InventorySourcePostRequest
+}
+
+// Name sets the name of the new inventory source. It is mandatory.
+//
+func (r *InventorySourcesPostRequest) Name(value string) *InventorySourcesPostRequest {
+	r.input.Name = &value
+	return r
+}
+
+// Description sets the description of the new inventory source.
+//
+func (r *InventorySourcesPostRequest) Description(value string) *InventorySourcesPostRequest {
+	r.input.Description = &value
+	return r
+}
+
+// Inventory sets the identifier of the inventory that the new source will populate. It is
+// mandatory, unless the request is sent to the collection of sources of an inventory.
+//
+func (r *InventorySourcesPostRequest) Inventory(value int) *InventorySourcesPostRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+// Source sets the type of the new source, for example 'scm', 'ec2' or 'vmware'.
+//
+func (r *InventorySourcesPostRequest) Source(value string) *InventorySourcesPostRequest {
+	r.input.Source = &value
+	return r
+}
+
+// SourcePath sets the path of the inventory file inside the project, for 'scm' sources.
+//
+func (r *InventorySourcesPostRequest) SourcePath(value string) *InventorySourcesPostRequest {
+	r.input.SourcePath = &value
+	return r
+}
+
+// SourceProject sets the identifier of the project that contains the inventory file, for 'scm'
+// sources.
+//
+func (r *InventorySourcesPostRequest) SourceProject(value int) *InventorySourcesPostRequest {
+	r.input.SourceProject = &value
+	return r
+}
+
+// SourceVars sets the variables passed to the inventory plugin or script, as YAML or JSON text.
+//
+func (r *InventorySourcesPostRequest) SourceVars(value string) *InventorySourcesPostRequest {
+	r.input.SourceVars = &value
+	return r
+}
+
+// Credential sets the identifier of the credential used to access the source.
+//
+func (r *InventorySourcesPostRequest) Credential(value int) *InventorySourcesPostRequest {
+	r.input.Credential = &value
+	return r
+}
+
+// Overwrite sets the flag that indicates if hosts and groups that no longer exist in the source
+// should be removed from the inventory.
+//
+func (r *InventorySourcesPostRequest) Overwrite(value bool) *InventorySourcesPostRequest {
+	r.input.Overwrite = &value
+	return r
+}
+
+// OverwriteVars sets the flag that indicates if the variables in the inventory should be replaced
+// by the ones of the source, instead of merged.
+//
+func (r *InventorySourcesPostRequest) OverwriteVars(value bool) *InventorySourcesPostRequest {
+	r.input.OverwriteVars = &value
+	return r
+}
+
+// UpdateOnLaunch sets the flag that indicates if the source should be updated each time a job
+// using the inventory is launched.
+//
+func (r *InventorySourcesPostRequest) UpdateOnLaunch(value bool) *InventorySourcesPostRequest {
+	r.input.UpdateOnLaunch = &value
+	return r
+}
+
+// UpdateCacheTimeout sets the number of seconds that the result of an update is considered
+// current when UpdateOnLaunch is true.
+//
+func (r *InventorySourcesPostRequest) UpdateCacheTimeout(value int) *InventorySourcesPostRequest {
+	r.input.UpdateCacheTimeout = &value
+	return r
+}
+
+func (r *InventorySourcesPostRequest) Send() (response *InventorySourcesPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventorySourcesPostRequest) SendContext(ctx context.Context) (response *InventorySourcesPostResponse, err error) {
+	output := new(data.InventorySourcePostResponse)
+	err = r.post(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(InventorySourcesPostResponse)
+	response.result = newInventorySource(&output.InventorySource)
+	return
+}
+
+type InventorySourcesPostResponse struct {
+	result *InventorySource
+}
+
+func (r *InventorySourcesPostResponse) Result() *InventorySource {
+	return r.result
+}
@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the inventories resources.
+
+package awx
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestInventoriesGetFilter(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/inventories/", http.StatusOK, `{
+		"count": 1,
+		"results": [{
+			"id": 3,
+			"name": "myinventory",
+			"organization": 1,
+			"kind": "smart",
+			"host_filter": "name__icontains=web",
+			"total_hosts": 2,
+			"total_groups": 1
+		}]
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Get().
+		Filter("name", "myinventory").
+		Filter("organization", 1).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := server.last(t, http.MethodGet, "/api/v2/inventories/").query
+	if query.Get("name") != "myinventory" || query.Get("organization") != "1" {
+		t.Errorf("Expected the name and organization filters, got '%s'", query.Encode())
+	}
+	results := response.Results()
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 inventory, got %d", len(results))
+	}
+	inventory := results[0]
+	if inventory.Id() != 3 || inventory.Name() != "myinventory" || inventory.Organization() != 1 {
+		t.Errorf("Unexpected inventory %+v", inventory)
+	}
+	if inventory.Kind() != InventoryKindSmart || inventory.HostFilter() != "name__icontains=web" {
+		t.Errorf("Unexpected inventory %+v", inventory)
+	}
+	if inventory.TotalHosts() != 2 || inventory.TotalGroups() != 1 {
+		t.Errorf("Unexpected inventory %+v", inventory)
+	}
+}
+
+func TestInventoriesPost(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/inventories/", http.StatusCreated, `{
+		"id": 3,
+		"name": "myinventory"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Post().
+		Name("myinventory").
+		Organization(1).
+		Kind(InventoryKindSmart).
+		HostFilter("name__icontains=web").
+		Variable("env", "prod").
+		Variable("replicas", 2).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/inventories/").body
+	if body["name"] != "myinventory" || body["organization"] != 1.0 {
+		t.Errorf("Expected the name and organization, got %v", body)
+	}
+	if body["kind"] != "smart" || body["host_filter"] != "name__icontains=web" {
+		t.Errorf("Expected the kind and host filter, got %v", body)
+	}
+	if _, ok := body["description"]; ok {
+		t.Errorf("Expected no description, got %v", body)
+	}
+	variables := decodeVariablesField(t, body, "variables")
+	expected := map[string]interface{}{
+		"env":      "prod",
+		"replicas": 2.0,
+	}
+	if !reflect.DeepEqual(variables, expected) {
+		t.Errorf("Expected variables %v, got %v", expected, variables)
+	}
+	if response.Result().Id() != 3 {
+		t.Errorf("Expected identifier 3, got %d", response.Result().Id())
+	}
+}
+
+func TestInventoryPatchSendsOnlyChanges(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPatch, "/api/v2/inventories/3/", http.StatusOK, `{
+		"id": 3,
+		"name": "myinventory",
+		"description": "My inventory"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Id(3).Patch().
+		Description("My inventory").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPatch, "/api/v2/inventories/3/").body
+	expected := map[string]interface{}{
+		"description": "My inventory",
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+	if response.Result().Description() != "My inventory" {
+		t.Errorf("Unexpected description '%s'", response.Result().Description())
+	}
+}
+
+func TestInventoryPutSendsVariables(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	connection := server.connect(t)
+
+	_, err := connection.Inventories().Id(3).Put().
+		Name("myinventory").
+		Organization(1).
+		Variables(map[string]interface{}{"env": "staging"}).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPut, "/api/v2/inventories/3/").body
+	if body["name"] != "myinventory" || body["organization"] != 1.0 {
+		t.Errorf("Expected the name and organization, got %v", body)
+	}
+	variables := decodeVariablesField(t, body, "variables")
+	if variables["env"] != "staging" {
+		t.Errorf("Expected variable 'env' to be 'staging', got %v", variables)
+	}
+}
+
+func TestInventoryDelete(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodDelete, "/api/v2/inventories/3/", http.StatusNoContent, "")
+	connection := server.connect(t)
+
+	_, err := connection.Inventories().Id(3).Delete().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := server.last(t, http.MethodDelete, "/api/v2/inventories/3/")
+	if request.body != nil {
+		t.Errorf("Expected no body, got %v", request.body)
+	}
+}
+
+func TestInventoryGetNotFound(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/inventories/4/", http.StatusNotFound, `{"detail": "Not found."}`)
+	connection := server.connect(t)
+
+	response, err := connection.Inventories().Id(4).Get().Send()
+	if err == nil {
+		t.Errorf("Expected an error, got inventory %+v", response.Result())
+	}
+}
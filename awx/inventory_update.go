@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the inventory update type.
+
+package awx
+
+import (
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// InventoryUpdate represents the job that updates an inventory from one of its sources.
+//
+type InventoryUpdate struct {
+	id              int
+	name            string
+	status          JobStatus
+	failed          bool
+	inventorySource int
+	jobExplanation  string
+}
+
+// newInventoryUpdate creates a new inventory update from the data returned by the server.
+//
+func newInventoryUpdate(output *data.InventoryUpdate) *InventoryUpdate {
+	update := new(InventoryUpdate)
+	update.id = output.Id
+	update.name = output.Name
+	update.status = (JobStatus)(output.Status)
+	update.failed = output.Failed
+	update.inventorySource = output.InventorySource
+	update.jobExplanation = output.JobExplanation
+	return update
+}
+
+func (u *InventoryUpdate) Id() int {
+	return u.id
+}
+
+func (u *InventoryUpdate) Name() string {
+	return u.name
+}
+
+func (u *InventoryUpdate) Status() JobStatus {
+	return u.status
+}
+
+func (u *InventoryUpdate) Failed() bool {
+	return u.failed
+}
+
+// InventorySource returns the identifier of the inventory source that is being updated.
+//
+func (u *InventoryUpdate) InventorySource() int {
+	return u.inventorySource
+}
+
+// JobExplanation returns the explanation given by the server when the update couldn't run, for
+// example because a dependency failed.
+//
+func (u *InventoryUpdate) JobExplanation() string {
+	return u.jobExplanation
+}
+
+func (u *InventoryUpdate) IsFinished() bool {
+	return u.status.IsFinished()
+}
+
+func (u *InventoryUpdate) IsSuccessful() bool {
+	return u.status == JobStatusSuccesful
+}
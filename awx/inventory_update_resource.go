@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a specific inventory
+// update.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type InventoryUpdateResource struct {
+	Resource
+}
+
+func NewInventoryUpdateResource(connection *Connection, path string) *InventoryUpdateResource {
+	resource := new(InventoryUpdateResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *InventoryUpdateResource) Get() *InventoryUpdateGetRequest {
+	request := new(InventoryUpdateGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Stdout returns a request that retrieves the output of the update.
+//
+func (r *InventoryUpdateResource) Stdout() *StdoutGetRequest {
+	return newStdoutGetRequest(r.connection, r.path+"/stdout")
+}
+
+type InventoryUpdateGetRequest struct {
+	Request
+}
+
+func (r *InventoryUpdateGetRequest) Send() (response *InventoryUpdateGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventoryUpdateGetRequest) SendContext(ctx context.Context) (response *InventoryUpdateGetResponse, err error) {
+	output := new(data.InventoryUpdateGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(InventoryUpdateGetResponse)
+	response.result = newInventoryUpdate(&output.InventoryUpdate)
+	return
+}
+
+type InventoryUpdateGetResponse struct {
+	result *InventoryUpdate
+}
+
+func (r *InventoryUpdateGetResponse) Result() *InventoryUpdate {
+	return r.result
+}
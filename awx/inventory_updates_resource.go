@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a collection of inventory
+// updates.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type InventoryUpdatesResource struct {
+	Resource
+}
+
+func NewInventoryUpdatesResource(connection *Connection, path string) *InventoryUpdatesResource {
+	resource := new(InventoryUpdatesResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *InventoryUpdatesResource) Get() *InventoryUpdatesGetRequest {
+	request := new(InventoryUpdatesGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Id returns the resource that manages the inventory update with the given identifier. Note that
+// inventory updates are always managed using the top level 'inventory_updates' collection, even
+// when this resource is the collection of updates of an inventory source.
+//
+func (r *InventoryUpdatesResource) Id(id int) *InventoryUpdateResource {
+	return NewInventoryUpdateResource(r.connection, fmt.Sprintf("inventory_updates/%d", id))
+}
+
+type InventoryUpdatesGetRequest struct {
+	Request
+}
+
+func (r *InventoryUpdatesGetRequest) Filter(name string, value interface{}) *InventoryUpdatesGetRequest {
+	r.addFilter(name, value)
+	return r
+}
+
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *InventoryUpdatesGetRequest) PageSize(value int) *InventoryUpdatesGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the inventory updates.
+// It stops and returns the error if the function returns an error.
+//
+func (r *InventoryUpdatesGetRequest) Each(f func(inventoryUpdate *InventoryUpdate) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *InventoryUpdatesGetRequest) EachContext(ctx context.Context, f func(inventoryUpdate *InventoryUpdate) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, inventoryUpdate := range response.results {
+			err = f(inventoryUpdate)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the inventory updates.
+//
+func (r *InventoryUpdatesGetRequest) All() (results []*InventoryUpdate, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *InventoryUpdatesGetRequest) AllContext(ctx context.Context) (results []*InventoryUpdate, err error) {
+	err = r.EachContext(ctx, func(inventoryUpdate *InventoryUpdate) error {
+		results = append(results, inventoryUpdate)
+		return nil
+	})
+	return
+}
+
+func (r *InventoryUpdatesGetRequest) Send() (response *InventoryUpdatesGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *InventoryUpdatesGetRequest) SendContext(ctx context.Context) (response *InventoryUpdatesGetResponse, err error) {
+	output := new(data.InventoryUpdatesGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(InventoryUpdatesGetResponse)
+	response.count = output.Count
+	response.previous = output.Previous
+	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
+	response.results = make([]*InventoryUpdate, len(output.Results))
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newInventoryUpdate(output.Results[i])
+	}
+	return
+}
+
+type InventoryUpdatesGetResponse struct {
+	ListGetResponse
+
+	results []*InventoryUpdate
+}
+
+func (r *InventoryUpdatesGetResponse) Results() []*InventoryUpdate {
+	return r.results
+}
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *InventoryUpdatesGetResponse) NextPage() (response *InventoryUpdatesGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(InventoryUpdatesGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
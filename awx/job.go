@@ -18,6 +18,13 @@ limitations under the License.
 
 package awx
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
 type JobStatus string
 
 const (
@@ -31,31 +38,235 @@ const (
 	JobStatusCancelled JobStatus = "cancelled"
 )
 
+// IsFinished returns true if the status is one of the final states of a job, regardless of it
+// being successful or not.
+//
+func (s JobStatus) IsFinished() bool {
+	switch s {
+	case
+		JobStatusSuccesful,
+		JobStatusFailed,
+		JobStatusError,
+		JobStatusCancelled:
+		return true
+	}
+	return false
+}
+
 type Job struct {
-	id     int
-	status JobStatus
+	id                      int
+	name                    string
+	status                  JobStatus
+	jobTemplate             int
+	inventory               int
+	project                 int
+	playbook                string
+	launchType              string
+	created                 time.Time
+	started                 time.Time
+	finished                time.Time
+	elapsed                 time.Duration
+	failed                  bool
+	jobExplanation          string
+	resultTraceback         string
+	eventProcessingFinished bool
+	limit                   string
+	extraVars               map[string]interface{}
+	artifacts               map[string]interface{}
+	launchedBy              *JobLaunchedBy
+}
+
+// newJob creates a new job from the data returned by the server.
+//
+func newJob(output *data.Job) *Job {
+	job := new(Job)
+	job.id = output.Id
+	job.name = output.Name
+	job.status = (JobStatus)(output.Status)
+	job.jobTemplate = output.JobTemplate
+	job.inventory = output.Inventory
+	job.project = output.Project
+	job.playbook = output.Playbook
+	job.launchType = output.LaunchType
+	job.created = output.Created
+	job.started = output.Started
+	job.finished = output.Finished
+	job.elapsed = time.Duration(output.Elapsed * float64(time.Second))
+	job.failed = output.Failed
+	job.jobExplanation = output.JobExplanation
+	job.resultTraceback = output.ResultTraceback
+	job.limit = output.Limit
+	job.artifacts = output.Artifacts
+	if output.LaunchedBy != nil {
+		job.launchedBy = new(JobLaunchedBy)
+		job.launchedBy.id = output.LaunchedBy.Id
+		job.launchedBy.name = output.LaunchedBy.Name
+		job.launchedBy.kind = output.LaunchedBy.Type
+	}
+
+	// The server always stores the extra variables of jobs as JSON, but ignore them if they can't
+	// be decoded, instead of failing to return the rest of the job:
+	if output.ExtraVars != "" {
+		var extraVars map[string]interface{}
+		if json.Unmarshal([]byte(output.ExtraVars), &extraVars) == nil {
+			job.extraVars = extraVars
+		}
+	}
+
+	// Servers that don't report if they have finished processing the events are assumed to have
+	// done it, otherwise following the output of their jobs would never end:
+	job.eventProcessingFinished = output.EventProcessingFinished == nil || *output.EventProcessingFinished
+
+	return job
 }
 
 func (j *Job) Id() int {
 	return j.id
 }
 
+func (j *Job) Name() string {
+	return j.name
+}
+
 func (j *Job) Status() JobStatus {
 	return j.status
 }
 
+// JobTemplate returns the identifier of the template that the job was launched from.
+//
+func (j *Job) JobTemplate() int {
+	return j.jobTemplate
+}
+
+// Inventory returns the identifier of the inventory used by the job.
+//
+func (j *Job) Inventory() int {
+	return j.inventory
+}
+
+// Project returns the identifier of the project that contains the playbook.
+//
+func (j *Job) Project() int {
+	return j.project
+}
+
+// Playbook returns the name of the playbook, relative to the project directory.
+//
+func (j *Job) Playbook() string {
+	return j.playbook
+}
+
+// LaunchType returns how the job was launched, for example 'manual', 'relaunch', 'callback',
+// 'scheduled' or 'workflow'.
+//
+func (j *Job) LaunchType() string {
+	return j.launchType
+}
+
+// Created returns the time when the job was created.
+//
+func (j *Job) Created() time.Time {
+	return j.created
+}
+
+// Started returns the time when the job started to run, or the zero time if it hasn't started
+// yet.
+//
+func (j *Job) Started() time.Time {
+	return j.started
+}
+
+// Finished returns the time when the job finished, or the zero time if it hasn't finished yet.
+//
+func (j *Job) Finished() time.Time {
+	return j.finished
+}
+
+// Elapsed returns the time that the job has been running.
+//
+func (j *Job) Elapsed() time.Duration {
+	return j.elapsed
+}
+
+// Failed returns true if the job failed.
+//
+func (j *Job) Failed() bool {
+	return j.failed
+}
+
+// JobExplanation returns the explanation given by the server when the job couldn't run, for
+// example because a dependency failed or because it was cancelled.
+//
+func (j *Job) JobExplanation() string {
+	return j.jobExplanation
+}
+
+// ResultTraceback returns the traceback of the error that caused the job to fail, if any.
+//
+func (j *Job) ResultTraceback() string {
+	return j.resultTraceback
+}
+
+// EventProcessingFinished returns true if the server has finished saving the events generated by
+// the job. The events of a job that has already finished may still be incomplete while this is
+// false. Old servers don't report it, and then it is always true.
+//
+func (j *Job) EventProcessingFinished() bool {
+	return j.eventProcessingFinished
+}
+
+// Limit returns the host pattern that restricted the hosts the job ran on.
+//
+func (j *Job) Limit() string {
+	return j.limit
+}
+
+// ExtraVars returns the extra variables of the job.
+//
+func (j *Job) ExtraVars() map[string]interface{} {
+	return j.extraVars
+}
+
+// Artifacts returns the facts set with 'set_stats' by the playbook.
+//
+func (j *Job) Artifacts() map[string]interface{} {
+	return j.artifacts
+}
+
+// LaunchedBy returns the user or system entity that launched the job, or nil if the server didn't
+// return it.
+//
+func (j *Job) LaunchedBy() *JobLaunchedBy {
+	return j.launchedBy
+}
+
 func (j *Job) IsFinished() bool {
-	switch j.status {
-	case
-		JobStatusSuccesful,
-		JobStatusFailed,
-		JobStatusError,
-		JobStatusCancelled:
-		return true
-	}
-	return false
+	return j.status.IsFinished()
 }
 
 func (j *Job) IsSuccessful() bool {
 	return j.status == JobStatusSuccesful
 }
+
+// JobLaunchedBy describes who launched a job.
+//
+type JobLaunchedBy struct {
+	id   int
+	name string
+	kind string
+}
+
+func (l *JobLaunchedBy) Id() int {
+	return l.id
+}
+
+func (l *JobLaunchedBy) Name() string {
+	return l.name
+}
+
+// Type returns the type of the entity that launched the job, for example 'user', 'schedule' or
+// 'workflow_job'.
+//
+func (l *JobLaunchedBy) Type() string {
+	return l.kind
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the job event type.
+
+package awx
+
+import (
+	"time"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type JobEventType string
+
+const (
+	JobEventPlaybookOnStart       JobEventType = "playbook_on_start"
+	JobEventPlaybookOnPlayStart   JobEventType = "playbook_on_play_start"
+	JobEventPlaybookOnTaskStart   JobEventType = "playbook_on_task_start"
+	JobEventPlaybookOnStats       JobEventType = "playbook_on_stats"
+	JobEventRunnerOnOk            JobEventType = "runner_on_ok"
+	JobEventRunnerOnFailed        JobEventType = "runner_on_failed"
+	JobEventRunnerOnSkipped       JobEventType = "runner_on_skipped"
+	JobEventRunnerOnUnreachable   JobEventType = "runner_on_unreachable"
+	JobEventRunnerItemOnOk        JobEventType = "runner_item_on_ok"
+	JobEventRunnerItemOnFailed    JobEventType = "runner_item_on_failed"
+	JobEventRunnerItemOnSkipped   JobEventType = "runner_item_on_skipped"
+	JobEventRunnerRetry           JobEventType = "runner_retry"
+	JobEventRunnerOnAsyncFailed   JobEventType = "runner_on_async_failed"
+	JobEventPlaybookOnNoHostsLeft JobEventType = "playbook_on_no_hosts_remaining"
+)
+
+type JobEvent struct {
+	id        int
+	counter   int
+	event     JobEventType
+	host      int
+	hostName  string
+	task      string
+	play      string
+	role      string
+	stdout    string
+	eventData map[string]interface{}
+	failed    bool
+	changed   bool
+	created   time.Time
+	modified  time.Time
+}
+
+func newJobEvent(output *data.JobEvent) *JobEvent {
+	event := new(JobEvent)
+	event.id = output.Id
+	event.counter = output.Counter
+	event.event = JobEventType(output.Event)
+	event.host = output.Host
+	event.hostName = output.HostName
+	event.task = output.Task
+	event.play = output.Play
+	event.role = output.Role
+	event.stdout = output.Stdout
+	event.eventData = output.EventData
+	event.failed = output.Failed
+	event.changed = output.Changed
+	event.created = output.Created
+	event.modified = output.Modified
+	return event
+}
+
+func (e *JobEvent) Id() int {
+	return e.id
+}
+
+// Counter returns the position of the event inside the sequence of events of the job.
+//
+func (e *JobEvent) Counter() int {
+	return e.counter
+}
+
+func (e *JobEvent) Event() JobEventType {
+	return e.event
+}
+
+// Host returns the identifier of the host that the event refers to, or zero if it doesn't refer to
+// a host, or the host isn't part of the inventory.
+//
+func (e *JobEvent) Host() int {
+	return e.host
+}
+
+func (e *JobEvent) HostName() string {
+	return e.hostName
+}
+
+func (e *JobEvent) Task() string {
+	return e.task
+}
+
+func (e *JobEvent) Play() string {
+	return e.play
+}
+
+func (e *JobEvent) Role() string {
+	return e.role
+}
+
+// Stdout returns the output generated by the event, without the trailing new line.
+//
+func (e *JobEvent) Stdout() string {
+	return e.stdout
+}
+
+// EventData returns the details of the event sent by Ansible, for example the result of the task
+// in the 'res' entry.
+//
+func (e *JobEvent) EventData() map[string]interface{} {
+	return e.eventData
+}
+
+func (e *JobEvent) Failed() bool {
+	return e.failed
+}
+
+func (e *JobEvent) Changed() bool {
+	return e.changed
+}
+
+func (e *JobEvent) Created() time.Time {
+	return e.created
+}
+
+func (e *JobEvent) Modified() time.Time {
+	return e.modified
+}
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the request used to list the events of a job.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// JobEventsGetRequest retrieves the events of a job, ordered by counter.
+//
+type JobEventsGetRequest struct {
+	Request
+}
+
+func newJobEventsGetRequest(connection *Connection, path string) *JobEventsGetRequest {
+	request := new(JobEventsGetRequest)
+	request.resource = &Resource{
+		connection: connection,
+		path:       path,
+	}
+	request.setParameter("order_by", "counter")
+	return request
+}
+
+func (r *JobEventsGetRequest) Filter(name string, value interface{}) *JobEventsGetRequest {
+	r.addFilter(name, value)
+	return r
+}
+
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *JobEventsGetRequest) PageSize(value int) *JobEventsGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the job events.
+// It stops and returns the error if the function returns an error.
+//
+func (r *JobEventsGetRequest) Each(f func(event *JobEvent) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *JobEventsGetRequest) EachContext(ctx context.Context, f func(event *JobEvent) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, event := range response.results {
+			err = f(event)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the job events.
+//
+func (r *JobEventsGetRequest) All() (results []*JobEvent, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *JobEventsGetRequest) AllContext(ctx context.Context) (results []*JobEvent, err error) {
+	err = r.EachContext(ctx, func(event *JobEvent) error {
+		results = append(results, event)
+		return nil
+	})
+	return
+}
+
+// Event selects only the events of the given type.
+//
+func (r *JobEventsGetRequest) Event(value JobEventType) *JobEventsGetRequest {
+	r.setParameter("event", value)
+	return r
+}
+
+// Host selects only the events that refer to the host with the given name.
+//
+func (r *JobEventsGetRequest) Host(value string) *JobEventsGetRequest {
+	r.setParameter("host_name", value)
+	return r
+}
+
+// Failed selects only the events that failed, or only the events that didn't fail.
+//
+func (r *JobEventsGetRequest) Failed(value bool) *JobEventsGetRequest {
+	r.setParameter("failed", value)
+	return r
+}
+
+// Changed selects only the events that changed something, or only the events that didn't.
+//
+func (r *JobEventsGetRequest) Changed(value bool) *JobEventsGetRequest {
+	r.setParameter("changed", value)
+	return r
+}
+
+// CounterAfter selects only the events with a counter greater than the given value.
+//
+func (r *JobEventsGetRequest) CounterAfter(value int) *JobEventsGetRequest {
+	r.setParameter("counter__gt", value)
+	return r
+}
+
+// CounterBefore selects only the events with a counter less than the given value.
+//
+func (r *JobEventsGetRequest) CounterBefore(value int) *JobEventsGetRequest {
+	r.setParameter("counter__lt", value)
+	return r
+}
+
+func (r *JobEventsGetRequest) Send() (response *JobEventsGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobEventsGetRequest) SendContext(ctx context.Context) (response *JobEventsGetResponse, err error) {
+	output := new(data.JobEventsGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(JobEventsGetResponse)
+	response.count = output.Count
+	response.previous = output.Previous
+	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
+	response.results = make([]*JobEvent, len(output.Results))
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newJobEvent(output.Results[i])
+	}
+	return
+}
+
+type JobEventsGetResponse struct {
+	ListGetResponse
+
+	results []*JobEvent
+}
+
+func (r *JobEventsGetResponse) Results() []*JobEvent {
+	return r.results
+}
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *JobEventsGetResponse) NextPage() (response *JobEventsGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(JobEventsGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
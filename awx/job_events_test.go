@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for listing the events of jobs.
+
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestJobEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/jobs/5/job_events/" {
+			t.Errorf("Unexpected path '%s'", r.URL.Path)
+		}
+		query := r.URL.Query()
+		expected := map[string]string{
+			"order_by":    "counter",
+			"event":       "runner_on_failed",
+			"host_name":   "web1",
+			"failed":      "true",
+			"counter__gt": "10",
+		}
+		for name, value := range expected {
+			if query.Get(name) != value {
+				t.Errorf("Expected parameter '%s' to be '%s', got '%s'", name, value, query.Get(name))
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"count": 1,
+			"results": [{
+				"id": 100,
+				"counter": 12,
+				"event": "runner_on_failed",
+				"host": 3,
+				"host_name": "web1",
+				"task": "Install packages",
+				"play": "Configure",
+				"role": "common",
+				"stdout": "fatal: [web1]: FAILED!",
+				"event_data": {"res": {"msg": "No package matching 'foo'"}},
+				"failed": true,
+				"changed": false,
+				"created": "2018-03-01T10:00:00Z"
+			}]
+		}`)
+	}))
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	response, err := connection.Jobs().Id(5).Events().
+		Event(JobEventRunnerOnFailed).
+		Host("web1").
+		Failed(true).
+		CounterAfter(10).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Count() != 1 || len(response.Results()) != 1 {
+		t.Fatalf("Expected one event, got %d", len(response.Results()))
+	}
+	event := response.Results()[0]
+	if event.Counter() != 12 || event.Event() != JobEventRunnerOnFailed || !event.Failed() {
+		t.Errorf("Unexpected counter %d, type '%s' or failed flag %v",
+			event.Counter(), event.Event(), event.Failed())
+	}
+	if event.Host() != 3 || event.HostName() != "web1" || event.Task() != "Install packages" ||
+		event.Play() != "Configure" || event.Role() != "common" {
+		t.Errorf("Unexpected host, task, play or role in event %+v", event)
+	}
+	if event.Created().IsZero() {
+		t.Errorf("Expected creation time to be set")
+	}
+	res, ok := event.EventData()["res"].(map[string]interface{})
+	if !ok || res["msg"] != "No package matching 'foo'" {
+		t.Errorf("Unexpected event data %v", event.EventData())
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the method that follows the output of a running job.
+
+package awx
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// jobFollowInterval is the time to wait between polls while following the output of a job.
+//
+var jobFollowInterval = time.Second
+
+// jobFollowPageSize is the number of job events requested in each poll.
+//
+const jobFollowPageSize = 200
+
+// jobFollowFinalPolls is the maximum number of polls done after the job has finished, waiting for
+// the server to finish processing its events. Some servers never report that they have finished,
+// so after this the output is considered complete.
+//
+const jobFollowFinalPolls = 60
+
+// jobFollowGapPolls is the number of polls that wait for a missing event before skipping it, as
+// the server may never save it.
+//
+const jobFollowGapPolls = 10
+
+// Follow returns a reader that returns the output of the job as it is generated, similar to the
+// 'tail -f' command. The output is obtained polling the events of the job, and the reader returns
+// end of file when the job has finished and all its output has been read. Events that the server
+// doesn't save after several polls are skipped. Errors found while polling, or cancellation of the
+// context, are returned by the Read method. The caller must close the reader when done, and that
+// also stops polling.
+//
+func (r *JobResource) Follow(ctx context.Context) io.ReadCloser {
+	ctx, cancel := context.WithCancel(ctx)
+	reader, writer := io.Pipe()
+	go func() {
+		err := r.follow(ctx, writer)
+		if err != nil && ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		writer.CloseWithError(err)
+	}()
+	return &jobFollowReader{
+		PipeReader: reader,
+		cancel:     cancel,
+	}
+}
+
+// follow writes the output of the job till it finishes. The status of the job is checked before
+// reading the events, and polling continues till the server reports that it has finished
+// processing the events of the job, so that all the events generated by the job are written. As
+// some servers never report it, polling stops anyhow after jobFollowFinalPolls polls once the job
+// has finished.
+//
+func (r *JobResource) follow(ctx context.Context, writer io.Writer) error {
+	counter := 0
+	finalPolls := 0
+	gapCounter := 0
+	gapPolls := 0
+	for {
+		response, err := r.Get().SendContext(ctx)
+		if err != nil {
+			return err
+		}
+		job := response.Job()
+		if job.IsFinished() {
+			finalPolls++
+		}
+		complete := job.IsFinished() && (job.EventProcessingFinished() || finalPolls >= jobFollowFinalPolls)
+		skipGaps := complete || gapPolls >= jobFollowGapPolls
+		var gap bool
+		counter, gap, err = r.followEvents(ctx, counter, skipGaps, writer)
+		if err != nil {
+			return err
+		}
+		if complete {
+			return nil
+		}
+
+		// Count the consecutive polls that stopped at the same gap, so that it is skipped when
+		// the missing event doesn't arrive:
+		switch {
+		case !gap:
+			gapPolls = 0
+		case counter != gapCounter:
+			gapCounter = counter
+			gapPolls = 1
+		default:
+			gapPolls++
+		}
+
+		timer := time.NewTimer(jobFollowInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// followEvents writes the output of the events that come after the given counter, and returns the
+// counter of the last event written. The server may save the events out of order, so unless gaps
+// should be skipped it stops at the first gap in the counters, and returns true to indicate it.
+// The events after the gap are retrieved again in the next poll, once the missing ones are
+// available. Missing events that arrive after their gap has been skipped aren't written.
+//
+func (r *JobResource) followEvents(ctx context.Context, counter int, skipGaps bool, writer io.Writer) (int, bool, error) {
+	for {
+		response, err := r.Events().
+			CounterAfter(counter).
+			PageSize(jobFollowPageSize).
+			SendContext(ctx)
+		if err != nil {
+			return counter, false, err
+		}
+		for _, event := range response.Results() {
+			if !skipGaps && event.Counter() != counter+1 {
+				return counter, true, nil
+			}
+			if event.Stdout() != "" {
+				_, err = io.WriteString(writer, event.Stdout()+"\n")
+				if err != nil {
+					return counter, false, err
+				}
+			}
+			counter = event.Counter()
+		}
+		if !response.HasNext() || len(response.Results()) == 0 {
+			return counter, false, nil
+		}
+	}
+}
+
+// jobFollowReader is the reader returned by the Follow method. Closing it stops the polling.
+//
+type jobFollowReader struct {
+	*io.PipeReader
+
+	cancel context.CancelFunc
+}
+
+func (r *jobFollowReader) Close() error {
+	r.cancel()
+	return r.PipeReader.Close()
+}
@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for following the output of jobs.
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// jobFollowSnapshot describes the state of the job with identifier 1 returned by the server
+// created by newJobFollowServer: the status, if the events have been completely processed, and the
+// counters of the events that are available. Legacy snapshots don't report if the events have been
+// processed, like old servers.
+type jobFollowSnapshot struct {
+	status    JobStatus
+	processed bool
+	legacy    bool
+	counters  []int
+}
+
+// newJobFollowServer creates a server that returns the given snapshots of the job with identifier
+// 1, moving to the next one each time that the job is retrieved, and repeating the last one when
+// exhausted. The events returned are the ones of the current snapshot.
+func newJobFollowServer(t *testing.T, snapshots ...jobFollowSnapshot) *httptest.Server {
+	current := -1
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/jobs/1/":
+			if current < len(snapshots)-1 {
+				current++
+			}
+			job := &data.Job{
+				Id:     1,
+				Status: string(snapshots[current].status),
+			}
+			if !snapshots[current].legacy {
+				processed := snapshots[current].processed
+				job.EventProcessingFinished = &processed
+			}
+			json.NewEncoder(w).Encode(job)
+		case "/api/v2/jobs/1/job_events/":
+			after, _ := strconv.Atoi(r.URL.Query().Get("counter__gt"))
+			counters := append([]int(nil), snapshots[current].counters...)
+			sort.Ints(counters)
+			output := new(data.JobEventsGetResponse)
+			for _, counter := range counters {
+				if counter > after {
+					output.Results = append(output.Results, &data.JobEvent{
+						Counter: counter,
+						Stdout:  fmt.Sprintf("event %d", counter),
+					})
+				}
+			}
+			json.NewEncoder(w).Encode(output)
+		default:
+			t.Errorf("Unexpected path '%s'", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// followJob reads the complete output of the job with identifier 1 from the given server. It fails
+// the test if the output doesn't end in a few seconds.
+func followJob(t *testing.T, server *httptest.Server) string {
+	connection := newTestConnection(t, server)
+	saved := jobFollowInterval
+	jobFollowInterval = time.Millisecond
+	defer func() {
+		jobFollowInterval = saved
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	reader := connection.Jobs().Id(1).Follow(ctx)
+	defer reader.Close()
+	output, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(output)
+}
+
+func TestJobFollow(t *testing.T) {
+	// Each time the job is retrieved one more event becomes available, and the job finishes when
+	// all of them are:
+	server := newJobFollowServer(t,
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1}},
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1, 2}},
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1, 2, 3}},
+		jobFollowSnapshot{status: JobStatusSuccesful, processed: true, counters: []int{1, 2, 3, 4}},
+	)
+	defer server.Close()
+
+	output := followJob(t, server)
+	expected := "event 1\nevent 2\nevent 3\nevent 4\n"
+	if output != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, output)
+	}
+}
+
+func TestJobFollowWaitsForEventProcessing(t *testing.T) {
+	// The job finishes before the server has saved all its events:
+	server := newJobFollowServer(t,
+		jobFollowSnapshot{status: JobStatusSuccesful, counters: []int{1}},
+		jobFollowSnapshot{status: JobStatusSuccesful, counters: []int{1, 2}},
+		jobFollowSnapshot{status: JobStatusSuccesful, processed: true, counters: []int{1, 2, 3}},
+	)
+	defer server.Close()
+
+	output := followJob(t, server)
+	expected := "event 1\nevent 2\nevent 3\n"
+	if output != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, output)
+	}
+}
+
+func TestJobFollowEventsOutOfOrder(t *testing.T) {
+	// Events are saved out of order, so some become available after others with higher
+	// counters:
+	server := newJobFollowServer(t,
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1, 3}},
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1, 3, 4}},
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1, 2, 3, 4, 6}},
+		jobFollowSnapshot{status: JobStatusSuccesful, processed: true, counters: []int{1, 2, 3, 4, 5, 6}},
+	)
+	defer server.Close()
+
+	output := followJob(t, server)
+	expected := "event 1\nevent 2\nevent 3\nevent 4\nevent 5\nevent 6\n"
+	if output != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, output)
+	}
+}
+
+func TestJobFollowGapWhenComplete(t *testing.T) {
+	// Once the events are complete the remaining gaps are never filled, so the events after them
+	// are written anyhow:
+	server := newJobFollowServer(t,
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1, 3}},
+		jobFollowSnapshot{status: JobStatusSuccesful, processed: true, counters: []int{1, 3, 4}},
+	)
+	defer server.Close()
+
+	output := followJob(t, server)
+	expected := "event 1\nevent 3\nevent 4\n"
+	if output != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, output)
+	}
+}
+
+func TestJobFollowEventProcessingNeverFinishes(t *testing.T) {
+	// The job has finished, but the server never reports that it has finished processing the
+	// events, so following stops after the final polls:
+	server := newJobFollowServer(t,
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1}},
+		jobFollowSnapshot{status: JobStatusSuccesful, counters: []int{1, 2}},
+	)
+	defer server.Close()
+
+	output := followJob(t, server)
+	expected := "event 1\nevent 2\n"
+	if output != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, output)
+	}
+}
+
+func TestJobFollowLegacyServer(t *testing.T) {
+	// Old servers don't report if the events have been processed, and the events of finished
+	// jobs are then considered complete:
+	server := newJobFollowServer(t,
+		jobFollowSnapshot{status: JobStatusRunning, legacy: true, counters: []int{1}},
+		jobFollowSnapshot{status: JobStatusFailed, legacy: true, counters: []int{1, 3}},
+	)
+	defer server.Close()
+
+	output := followJob(t, server)
+	expected := "event 1\nevent 3\n"
+	if output != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, output)
+	}
+}
+
+func TestJobFollowSkipsMissingEvent(t *testing.T) {
+	// Event 2 is missing for more polls than the follower waits for, so it is skipped and not
+	// written when it finally arrives:
+	var snapshots []jobFollowSnapshot
+	for i := 0; i <= jobFollowGapPolls; i++ {
+		snapshots = append(snapshots, jobFollowSnapshot{status: JobStatusRunning, counters: []int{1, 3}})
+	}
+	snapshots = append(snapshots,
+		jobFollowSnapshot{status: JobStatusRunning, counters: []int{1, 3, 4}},
+		jobFollowSnapshot{status: JobStatusSuccesful, processed: true, counters: []int{1, 2, 3, 4}},
+	)
+	server := newJobFollowServer(t, snapshots...)
+	defer server.Close()
+
+	output := followJob(t, server)
+	expected := "event 1\nevent 3\nevent 4\n"
+	if output != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, output)
+	}
+}
+
+func TestJobFollowCancel(t *testing.T) {
+	server := newJobStatusServer(JobStatusRunning)
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	saved := jobFollowInterval
+	jobFollowInterval = time.Millisecond
+	defer func() {
+		jobFollowInterval = saved
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	reader := connection.Jobs().Id(1).Follow(ctx)
+	defer reader.Close()
+	_, err := ioutil.ReadAll(reader)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected deadline exceeded error, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the request used to list the host summaries of a job.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// JobHostSummariesGetRequest retrieves the summary of the results of a job for each host.
+//
+type JobHostSummariesGetRequest struct {
+	Request
+}
+
+func newJobHostSummariesGetRequest(connection *Connection, path string) *JobHostSummariesGetRequest {
+	request := new(JobHostSummariesGetRequest)
+	request.resource = &Resource{
+		connection: connection,
+		path:       path,
+	}
+	return request
+}
+
+func (r *JobHostSummariesGetRequest) Filter(name string, value interface{}) *JobHostSummariesGetRequest {
+	r.addFilter(name, value)
+	return r
+}
+
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *JobHostSummariesGetRequest) PageSize(value int) *JobHostSummariesGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the job host summaries.
+// It stops and returns the error if the function returns an error.
+//
+func (r *JobHostSummariesGetRequest) Each(f func(summary *JobHostSummary) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *JobHostSummariesGetRequest) EachContext(ctx context.Context, f func(summary *JobHostSummary) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, summary := range response.results {
+			err = f(summary)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the job host summaries.
+//
+func (r *JobHostSummariesGetRequest) All() (results []*JobHostSummary, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *JobHostSummariesGetRequest) AllContext(ctx context.Context) (results []*JobHostSummary, err error) {
+	err = r.EachContext(ctx, func(summary *JobHostSummary) error {
+		results = append(results, summary)
+		return nil
+	})
+	return
+}
+
+// Failed selects only the hosts where the job failed, or only the ones where it didn't fail.
+//
+func (r *JobHostSummariesGetRequest) Failed(value bool) *JobHostSummariesGetRequest {
+	r.setParameter("failed", value)
+	return r
+}
+
+func (r *JobHostSummariesGetRequest) Send() (response *JobHostSummariesGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobHostSummariesGetRequest) SendContext(ctx context.Context) (response *JobHostSummariesGetResponse, err error) {
+	output := new(data.JobHostSummariesGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(JobHostSummariesGetResponse)
+	response.count = output.Count
+	response.previous = output.Previous
+	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
+	response.results = make([]*JobHostSummary, len(output.Results))
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newJobHostSummary(output.Results[i])
+	}
+	return
+}
+
+type JobHostSummariesGetResponse struct {
+	ListGetResponse
+
+	results []*JobHostSummary
+}
+
+func (r *JobHostSummariesGetResponse) Results() []*JobHostSummary {
+	return r.results
+}
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *JobHostSummariesGetResponse) NextPage() (response *JobHostSummariesGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(JobHostSummariesGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
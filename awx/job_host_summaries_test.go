@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for listing the host summaries of jobs.
+
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestJobHostSummaries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/jobs/5/job_host_summaries/" {
+			t.Errorf("Unexpected path '%s'", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"count": 3,
+			"results": [
+				{"id": 1, "host": 10, "host_name": "web1", "ok": 5, "changed": 2},
+				{"id": 2, "host": 11, "host_name": "web2", "ok": 3, "failures": 1, "failed": true},
+				{"id": 3, "host": 12, "host_name": "db1", "dark": 1, "failed": true}
+			]
+		}`)
+	}))
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	response, err := connection.Jobs().Id(5).HostSummaries().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := response.Results()
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 summaries, got %d", len(results))
+	}
+	if results[0].HostName() != "web1" || results[0].Ok() != 5 || results[0].Changed() != 2 ||
+		results[0].IsFailed() || results[0].IsUnreachable() {
+		t.Errorf("Unexpected first summary %+v", results[0])
+	}
+	if !results[1].IsFailed() || results[1].IsUnreachable() || results[1].Failures() != 1 {
+		t.Errorf("Expected second summary to be failed, got %+v", results[1])
+	}
+	if !results[2].IsUnreachable() || results[2].Dark() != 1 || results[2].Host() != 12 {
+		t.Errorf("Expected third summary to be unreachable, got %+v", results[2])
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the job host summary type.
+
+package awx
+
+import (
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// JobHostSummary contains the number of tasks of a job that finished with each possible result in
+// one host.
+//
+type JobHostSummary struct {
+	id       int
+	host     int
+	hostName string
+	ok       int
+	changed  int
+	failures int
+	dark     int
+	skipped  int
+	rescued  int
+	ignored  int
+	failed   bool
+}
+
+func newJobHostSummary(output *data.JobHostSummary) *JobHostSummary {
+	summary := new(JobHostSummary)
+	summary.id = output.Id
+	summary.host = output.Host
+	summary.hostName = output.HostName
+	summary.ok = output.Ok
+	summary.changed = output.Changed
+	summary.failures = output.Failures
+	summary.dark = output.Dark
+	summary.skipped = output.Skipped
+	summary.rescued = output.Rescued
+	summary.ignored = output.Ignored
+	summary.failed = output.Failed
+	return summary
+}
+
+func (s *JobHostSummary) Id() int {
+	return s.id
+}
+
+// Host returns the identifier of the host, or zero if the host has been removed from the
+// inventory.
+//
+func (s *JobHostSummary) Host() int {
+	return s.host
+}
+
+func (s *JobHostSummary) HostName() string {
+	return s.hostName
+}
+
+func (s *JobHostSummary) Ok() int {
+	return s.ok
+}
+
+func (s *JobHostSummary) Changed() int {
+	return s.changed
+}
+
+func (s *JobHostSummary) Failures() int {
+	return s.failures
+}
+
+// Dark returns the number of tasks that couldn't run because the host was unreachable.
+//
+func (s *JobHostSummary) Dark() int {
+	return s.dark
+}
+
+func (s *JobHostSummary) Skipped() int {
+	return s.skipped
+}
+
+func (s *JobHostSummary) Rescued() int {
+	return s.rescued
+}
+
+func (s *JobHostSummary) Ignored() int {
+	return s.ignored
+}
+
+// IsFailed returns true if any task failed in the host.
+//
+func (s *JobHostSummary) IsFailed() bool {
+	return s.failed || s.failures > 0
+}
+
+// IsUnreachable returns true if the host was unreachable for any task.
+//
+func (s *JobHostSummary) IsUnreachable() bool {
+	return s.dark > 0
+}
@@ -20,9 +20,20 @@ limitations under the License.
 package awx
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/moolitayer/awx-client-go/awx/internal/data"
 )
 
+type RelaunchHosts string
+
+const (
+	RelaunchHostsAll    RelaunchHosts = "all"
+	RelaunchHostsFailed RelaunchHosts = "failed"
+)
+
 type JobResource struct {
 	Resource
 }
@@ -40,21 +51,64 @@ func (r *JobResource) Get() *JobGetRequest {
 	return request
 }
 
+// Cancel returns a request that cancels the job, if it is still pending or running.
+//
+func (r *JobResource) Cancel() *JobCancelPostRequest {
+	request := new(JobCancelPostRequest)
+	request.resource = &Resource{
+		connection: r.connection,
+		path:       r.path + "/cancel",
+	}
+	return request
+}
+
+// Relaunch returns a request that launches a new job with the same parameters than this one.
+//
+func (r *JobResource) Relaunch() *JobRelaunchPostRequest {
+	request := new(JobRelaunchPostRequest)
+	request.resource = &Resource{
+		connection: r.connection,
+		path:       r.path + "/relaunch",
+	}
+	return request
+}
+
+// Stdout returns a request that retrieves the output of the job.
+//
+func (r *JobResource) Stdout() *StdoutGetRequest {
+	return newStdoutGetRequest(r.connection, r.path+"/stdout")
+}
+
+// Events returns a request that retrieves the events generated by the job.
+//
+func (r *JobResource) Events() *JobEventsGetRequest {
+	return newJobEventsGetRequest(r.connection, r.path+"/job_events")
+}
+
+// HostSummaries returns a request that retrieves the summary of the results of the job for each
+// host.
+//
+func (r *JobResource) HostSummaries() *JobHostSummariesGetRequest {
+	return newJobHostSummariesGetRequest(r.connection, r.path+"/job_host_summaries")
+}
+
 type JobGetRequest struct {
 	Request
 }
 
 func (r *JobGetRequest) Send() (response *JobGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobGetRequest) SendContext(ctx context.Context) (response *JobGetResponse, err error) {
 	output := new(data.JobGetResponse)
-	err = r.get(output)
+	err = r.get(ctx, output)
 	if err != nil {
 		return nil, err
 	}
 	response = new(JobGetResponse)
 	if output != nil {
-		response.job = new(Job)
-		response.job.id = output.Id
-		response.job.status = (JobStatus)(output.Status)
+		response.job = newJob(&output.Job)
 	}
 	return
 }
@@ -66,3 +120,91 @@ type JobGetResponse struct {
 func (r *JobGetResponse) Job() *Job {
 	return r.job
 }
+
+type JobCancelPostRequest struct {
+	Request
+}
+
+// Send checks with the server that the job can be cancelled, and then cancels it. It returns an
+// error if the job can't be cancelled, for example because it has already finished.
+//
+func (r *JobCancelPostRequest) Send() (response *JobCancelPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobCancelPostRequest) SendContext(ctx context.Context) (response *JobCancelPostResponse, err error) {
+	check := new(data.JobCancelGetResponse)
+	err = r.get(ctx, check)
+	if err != nil {
+		return
+	}
+	if !check.CanCancel {
+		err = fmt.Errorf(
+			"The job '%s' can't be cancelled",
+			strings.TrimSuffix(r.resource.path, "/cancel"),
+		)
+		return
+	}
+	err = r.post(ctx, struct{}{}, nil)
+	if err != nil {
+		return
+	}
+	response = new(JobCancelPostResponse)
+	return
+}
+
+type JobCancelPostResponse struct {
+}
+
+type JobRelaunchPostRequest struct {
+	Request
+
+	input data.JobRelaunchPostRequest
+}
+
+// Hosts selects the hosts the new job runs on, either all the hosts of the original job or only
+// the ones that failed. The default is all the hosts.
+//
+func (r *JobRelaunchPostRequest) Hosts(value RelaunchHosts) *JobRelaunchPostRequest {
+	r.input.Hosts = string(value)
+	return r
+}
+
+// CredentialPassword sets a password required by the credentials of the job, for example
+// 'ssh_password'.
+//
+func (r *JobRelaunchPostRequest) CredentialPassword(name string, value string) *JobRelaunchPostRequest {
+	if r.input.CredentialPasswords == nil {
+		r.input.CredentialPasswords = make(map[string]string)
+	}
+	r.input.CredentialPasswords[name] = value
+	return r
+}
+
+func (r *JobRelaunchPostRequest) Send() (response *JobRelaunchPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobRelaunchPostRequest) SendContext(ctx context.Context) (response *JobRelaunchPostResponse, err error) {
+	output := new(data.JobRelaunchPostResponse)
+	err = r.post(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(JobRelaunchPostResponse)
+	response.job = newJob(&output.Job)
+	if output.JobId != 0 {
+		response.job.id = output.JobId
+	}
+	return
+}
+
+type JobRelaunchPostResponse struct {
+	job *Job
+}
+
+// Job returns the new job.
+//
+func (r *JobRelaunchPostResponse) Job() *Job {
+	return r.job
+}
@@ -18,25 +18,390 @@ limitations under the License.
 
 package awx
 
+import (
+	"time"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type JobType string
+
+const (
+	JobTypeRun   JobType = "run"
+	JobTypeCheck JobType = "check"
+)
+
 type JobTemplate struct {
-	id               int
-	name             string
-	askLimitOnLaunch bool
-	askVarsOnLaunch  bool
+	id                              int
+	name                            string
+	description                     string
+	jobType                         JobType
+	inventory                       int
+	project                         int
+	playbook                        string
+	scmBranch                       string
+	forks                           int
+	limit                           string
+	verbosity                       int
+	extraVars                       string
+	jobTags                         string
+	skipTags                        string
+	startAtTask                     string
+	timeout                         int
+	useFactCache                    bool
+	hostConfigKey                   string
+	diffMode                        bool
+	becomeEnabled                   bool
+	allowSimultaneous               bool
+	surveyEnabled                   bool
+	jobSliceCount                   int
+	executionEnvironment            int
+	askSCMBranchOnLaunch            bool
+	askDiffModeOnLaunch             bool
+	askVarsOnLaunch                 bool
+	askLimitOnLaunch                bool
+	askTagsOnLaunch                 bool
+	askSkipTagsOnLaunch             bool
+	askJobTypeOnLaunch              bool
+	askVerbosityOnLaunch            bool
+	askInventoryOnLaunch            bool
+	askCredentialOnLaunch           bool
+	askExecutionEnvironmentOnLaunch bool
+	askLabelsOnLaunch               bool
+	askForksOnLaunch                bool
+	askJobSliceCountOnLaunch        bool
+	askTimeoutOnLaunch              bool
+	askInstanceGroupsOnLaunch       bool
+	status                          JobStatus
+	lastJobRun                      time.Time
+	lastJobFailed                   bool
+}
+
+// newJobTemplate creates a new job template from the data returned by the server.
+//
+func newJobTemplate(output *data.JobTemplate) *JobTemplate {
+	template := new(JobTemplate)
+	template.id = output.Id
+	template.name = output.Name
+	template.description = output.Description
+	template.jobType = (JobType)(output.JobType)
+	template.inventory = output.Inventory
+	template.project = output.Project
+	template.playbook = output.Playbook
+	template.scmBranch = output.SCMBranch
+	template.forks = output.Forks
+	template.limit = output.Limit
+	template.verbosity = output.Verbosity
+	template.extraVars = output.ExtraVars
+	template.jobTags = output.JobTags
+	template.skipTags = output.SkipTags
+	template.startAtTask = output.StartAtTask
+	template.timeout = output.Timeout
+	template.useFactCache = output.UseFactCache
+	template.hostConfigKey = output.HostConfigKey
+	template.diffMode = output.DiffMode
+	template.becomeEnabled = output.BecomeEnabled
+	template.allowSimultaneous = output.AllowSimultaneous
+	template.surveyEnabled = output.SurveyEnabled
+	template.jobSliceCount = output.JobSliceCount
+	template.executionEnvironment = output.ExecutionEnvironment
+	template.askSCMBranchOnLaunch = output.AskSCMBranchOnLaunch
+	template.askDiffModeOnLaunch = output.AskDiffModeOnLaunch
+	template.askVarsOnLaunch = output.AskVarsOnLaunch
+	template.askLimitOnLaunch = output.AskLimitOnLaunch
+	template.askTagsOnLaunch = output.AskTagsOnLaunch
+	template.askSkipTagsOnLaunch = output.AskSkipTagsOnLaunch
+	template.askJobTypeOnLaunch = output.AskJobTypeOnLaunch
+	template.askVerbosityOnLaunch = output.AskVerbosityOnLaunch
+	template.askInventoryOnLaunch = output.AskInventoryOnLaunch
+	template.askCredentialOnLaunch = output.AskCredentialOnLaunch
+	template.askExecutionEnvironmentOnLaunch = output.AskExecutionEnvironmentOnLaunch
+	template.askLabelsOnLaunch = output.AskLabelsOnLaunch
+	template.askForksOnLaunch = output.AskForksOnLaunch
+	template.askJobSliceCountOnLaunch = output.AskJobSliceCountOnLaunch
+	template.askTimeoutOnLaunch = output.AskTimeoutOnLaunch
+	template.askInstanceGroupsOnLaunch = output.AskInstanceGroupsOnLaunch
+	template.status = (JobStatus)(output.Status)
+	template.lastJobRun = output.LastJobRun
+	template.lastJobFailed = output.LastJobFailed
+	return template
 }
 
+// Id returns the unique identifier of the job template.
+//
 func (t *JobTemplate) Id() int {
 	return t.id
 }
 
+// Name returns the name of the job template.
+//
 func (t *JobTemplate) Name() string {
 	return t.name
 }
 
-func (t *JobTemplate) AskLimitOnLaunch() bool {
-	return t.askLimitOnLaunch
+// Description returns the description of the job template.
+//
+func (t *JobTemplate) Description() string {
+	return t.description
+}
+
+// JobType returns the type of the jobs launched from the template, either run or check.
+//
+func (t *JobTemplate) JobType() JobType {
+	return t.jobType
+}
+
+// Inventory returns the identifier of the inventory used by the template.
+//
+func (t *JobTemplate) Inventory() int {
+	return t.inventory
+}
+
+// Project returns the identifier of the project that contains the playbook.
+//
+func (t *JobTemplate) Project() int {
+	return t.project
+}
+
+// Playbook returns the name of the playbook, relative to the project directory.
+//
+func (t *JobTemplate) Playbook() string {
+	return t.playbook
+}
+
+// SCMBranch returns the branch of the project used by the template, if the project allows
+// overriding it.
+//
+func (t *JobTemplate) SCMBranch() string {
+	return t.scmBranch
+}
+
+// Forks returns the number of parallel processes used to run the playbook. Zero means the default
+// of the server.
+//
+func (t *JobTemplate) Forks() int {
+	return t.forks
+}
+
+// Limit returns the host pattern that restricts the hosts the playbook runs on.
+//
+func (t *JobTemplate) Limit() string {
+	return t.limit
+}
+
+// Verbosity returns the verbosity level of the playbook output, from 0 (normal) to 5 (WinRM debug).
+//
+func (t *JobTemplate) Verbosity() int {
+	return t.verbosity
+}
+
+// ExtraVars returns the extra variables of the template, as the YAML or JSON text stored by the
+// server.
+//
+func (t *JobTemplate) ExtraVars() string {
+	return t.extraVars
+}
+
+// JobTags returns the comma separated list of tags of the tasks to run.
+//
+func (t *JobTemplate) JobTags() string {
+	return t.jobTags
+}
+
+// SkipTags returns the comma separated list of tags of the tasks to skip.
+//
+func (t *JobTemplate) SkipTags() string {
+	return t.skipTags
+}
+
+// StartAtTask returns the name of the task where the playbook starts.
+//
+func (t *JobTemplate) StartAtTask() string {
+	return t.startAtTask
+}
+
+// Timeout returns the number of seconds that the job can run before being cancelled. Zero means no
+// timeout.
+//
+func (t *JobTemplate) Timeout() int {
+	return t.timeout
+}
+
+// UseFactCache returns true if the facts gathered by the jobs are stored and made available to
+// later jobs.
+//
+func (t *JobTemplate) UseFactCache() bool {
+	return t.useFactCache
+}
+
+// HostConfigKey returns the key that allows hosts to request a provisioning callback.
+//
+func (t *JobTemplate) HostConfigKey() string {
+	return t.hostConfigKey
+}
+
+// DiffMode returns true if the jobs show the changes made by the tasks.
+//
+func (t *JobTemplate) DiffMode() bool {
+	return t.diffMode
+}
+
+// BecomeEnabled returns true if the playbook runs with privilege escalation.
+//
+func (t *JobTemplate) BecomeEnabled() bool {
+	return t.becomeEnabled
+}
+
+// AllowSimultaneous returns true if several jobs launched from the template can run at the same
+// time.
+//
+func (t *JobTemplate) AllowSimultaneous() bool {
+	return t.allowSimultaneous
 }
 
+// SurveyEnabled returns true if the survey of the template is presented on launch.
+//
+func (t *JobTemplate) SurveyEnabled() bool {
+	return t.surveyEnabled
+}
+
+// JobSliceCount returns the number of slices the jobs launched from the template are split into.
+//
+func (t *JobTemplate) JobSliceCount() int {
+	return t.jobSliceCount
+}
+
+// ExecutionEnvironment returns the identifier of the execution environment used to run the jobs.
+//
+func (t *JobTemplate) ExecutionEnvironment() int {
+	return t.executionEnvironment
+}
+
+// AskSCMBranchOnLaunch returns true if the SCM branch can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskSCMBranchOnLaunch() bool {
+	return t.askSCMBranchOnLaunch
+}
+
+// AskDiffModeOnLaunch returns true if the diff mode can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskDiffModeOnLaunch() bool {
+	return t.askDiffModeOnLaunch
+}
+
+// AskVarsOnLaunch returns true if the extra variables can be changed when launching a job from the
+// template.
+//
 func (t *JobTemplate) AskVarsOnLaunch() bool {
 	return t.askVarsOnLaunch
 }
+
+// AskLimitOnLaunch returns true if the limit can be changed when launching a job from the template.
+//
+func (t *JobTemplate) AskLimitOnLaunch() bool {
+	return t.askLimitOnLaunch
+}
+
+// AskTagsOnLaunch returns true if the job tags can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskTagsOnLaunch() bool {
+	return t.askTagsOnLaunch
+}
+
+// AskSkipTagsOnLaunch returns true if the skip tags can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskSkipTagsOnLaunch() bool {
+	return t.askSkipTagsOnLaunch
+}
+
+// AskJobTypeOnLaunch returns true if the job type can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskJobTypeOnLaunch() bool {
+	return t.askJobTypeOnLaunch
+}
+
+// AskVerbosityOnLaunch returns true if the verbosity can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskVerbosityOnLaunch() bool {
+	return t.askVerbosityOnLaunch
+}
+
+// AskInventoryOnLaunch returns true if the inventory can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskInventoryOnLaunch() bool {
+	return t.askInventoryOnLaunch
+}
+
+// AskCredentialOnLaunch returns true if the credentials can be changed when launching a job from
+// the template.
+//
+func (t *JobTemplate) AskCredentialOnLaunch() bool {
+	return t.askCredentialOnLaunch
+}
+
+// AskExecutionEnvironmentOnLaunch returns true if the execution environment can be changed when
+// launching a job from the template.
+//
+func (t *JobTemplate) AskExecutionEnvironmentOnLaunch() bool {
+	return t.askExecutionEnvironmentOnLaunch
+}
+
+// AskLabelsOnLaunch returns true if the labels can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskLabelsOnLaunch() bool {
+	return t.askLabelsOnLaunch
+}
+
+// AskForksOnLaunch returns true if the number of forks can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskForksOnLaunch() bool {
+	return t.askForksOnLaunch
+}
+
+// AskJobSliceCountOnLaunch returns true if the job slice count can be changed when launching a job
+// from the template.
+//
+func (t *JobTemplate) AskJobSliceCountOnLaunch() bool {
+	return t.askJobSliceCountOnLaunch
+}
+
+// AskTimeoutOnLaunch returns true if the timeout can be changed when launching a job from the
+// template.
+//
+func (t *JobTemplate) AskTimeoutOnLaunch() bool {
+	return t.askTimeoutOnLaunch
+}
+
+// AskInstanceGroupsOnLaunch returns true if the instance groups can be changed when launching a job
+// from the template.
+//
+func (t *JobTemplate) AskInstanceGroupsOnLaunch() bool {
+	return t.askInstanceGroupsOnLaunch
+}
+
+// Status returns the status of the last job launched from the template.
+//
+func (t *JobTemplate) Status() JobStatus {
+	return t.status
+}
+
+// LastJobRun returns the time when the last job launched from the template finished.
+//
+func (t *JobTemplate) LastJobRun() time.Time {
+	return t.lastJobRun
+}
+
+// LastJobFailed returns true if the last job launched from the template failed.
+//
+func (t *JobTemplate) LastJobFailed() bool {
+	return t.lastJobFailed
+}
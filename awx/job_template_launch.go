@@ -18,6 +18,129 @@ limitations under the License.
 
 package awx
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
 type JobTemplateLaunch struct {
 	JobTemplateData *JobTemplate `json:"job_template_data,omitempty"`
 }
+
+// JobTemplateLaunchDefaults contains the values of the template that will be used for the fields
+// that aren't given when launching a job.
+//
+type JobTemplateLaunchDefaults struct {
+	extraVars   string
+	inventory   int
+	limit       string
+	jobTags     string
+	skipTags    string
+	jobType     JobType
+	verbosity   int
+	diffMode    bool
+	scmBranch   string
+	credentials []int
+}
+
+func newJobTemplateLaunchDefaults(output *data.JobTemplateLaunchDefaults) *JobTemplateLaunchDefaults {
+	defaults := new(JobTemplateLaunchDefaults)
+	defaults.extraVars = output.ExtraVars
+	if output.Inventory != nil {
+		defaults.inventory = output.Inventory.Id
+	}
+	defaults.limit = output.Limit
+	defaults.jobTags = output.JobTags
+	defaults.skipTags = output.SkipTags
+	defaults.jobType = (JobType)(output.JobType)
+	defaults.verbosity = output.Verbosity
+	defaults.diffMode = output.DiffMode
+	defaults.scmBranch = output.SCMBranch
+	defaults.credentials = make([]int, len(output.Credentials))
+	for i, credential := range output.Credentials {
+		defaults.credentials[i] = credential.Id
+	}
+	return defaults
+}
+
+func (d *JobTemplateLaunchDefaults) ExtraVars() string {
+	return d.extraVars
+}
+
+// Inventory returns the identifier of the inventory of the template, or zero if the template
+// doesn't have one.
+//
+func (d *JobTemplateLaunchDefaults) Inventory() int {
+	return d.inventory
+}
+
+func (d *JobTemplateLaunchDefaults) Limit() string {
+	return d.limit
+}
+
+func (d *JobTemplateLaunchDefaults) JobTags() string {
+	return d.jobTags
+}
+
+func (d *JobTemplateLaunchDefaults) SkipTags() string {
+	return d.skipTags
+}
+
+func (d *JobTemplateLaunchDefaults) JobType() JobType {
+	return d.jobType
+}
+
+func (d *JobTemplateLaunchDefaults) Verbosity() int {
+	return d.verbosity
+}
+
+func (d *JobTemplateLaunchDefaults) DiffMode() bool {
+	return d.diffMode
+}
+
+func (d *JobTemplateLaunchDefaults) SCMBranch() string {
+	return d.scmBranch
+}
+
+// Credentials returns the identifiers of the credentials of the template.
+//
+func (d *JobTemplateLaunchDefaults) Credentials() []int {
+	return d.credentials
+}
+
+// JobTemplateLaunchValidationError is the error returned when a launch request doesn't satisfy the
+// requirements of the template. It contains the names of the fields, passwords and survey
+// variables that are required but haven't been given, and the names of the fields that have been
+// given but that the template doesn't allow to change on launch.
+//
+type JobTemplateLaunchValidationError struct {
+	missing    []string
+	disallowed []string
+}
+
+// Missing returns the names of the fields, credential passwords and survey variables that are
+// required to launch the job but haven't been given.
+//
+func (e *JobTemplateLaunchValidationError) Missing() []string {
+	return e.missing
+}
+
+// Disallowed returns the names of the fields that have been given but that the template doesn't
+// allow to change on launch.
+//
+func (e *JobTemplateLaunchValidationError) Disallowed() []string {
+	return e.disallowed
+}
+
+func (e *JobTemplateLaunchValidationError) Error() string {
+	var problems []string
+	if len(e.missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing %s", strings.Join(e.missing, ", ")))
+	}
+	if len(e.disallowed) > 0 {
+		problems = append(problems, fmt.Sprintf("not allowed on launch %s", strings.Join(e.disallowed, ", ")))
+	}
+	return fmt.Sprintf("The job can't be launched: %s", strings.Join(problems, "; "))
+}
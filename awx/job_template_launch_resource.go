@@ -20,6 +20,7 @@ limitations under the License.
 package awx
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/moolitayer/awx-client-go/awx/internal/data"
@@ -53,22 +54,218 @@ type JobTemplateLaunchGetRequest struct {
 }
 
 func (r *JobTemplateLaunchGetRequest) Send() (response *JobTemplateLaunchGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobTemplateLaunchGetRequest) SendContext(ctx context.Context) (response *JobTemplateLaunchGetResponse, err error) {
 	output := new(data.JobTemplateLaunchGetResponse)
-	err = r.get(output)
+	err = r.get(ctx, output)
 	if err != nil {
 		return
 	}
 	response = new(JobTemplateLaunchGetResponse)
+	response.canStartWithoutUserInput = output.CanStartWithoutUserInput
+	response.passwordsNeededToStart = output.PasswordsNeededToStart
+	response.variablesNeededToStart = output.VariablesNeededToStart
+	response.inventoryNeededToStart = output.InventoryNeededToStart
+	response.credentialNeededToStart = output.CredentialNeededToStart
+	response.surveyEnabled = output.SurveyEnabled
+	response.askSCMBranchOnLaunch = output.AskSCMBranchOnLaunch
+	response.askDiffModeOnLaunch = output.AskDiffModeOnLaunch
+	response.askVarsOnLaunch = output.AskVarsOnLaunch
+	response.askLimitOnLaunch = output.AskLimitOnLaunch
+	response.askTagsOnLaunch = output.AskTagsOnLaunch
+	response.askSkipTagsOnLaunch = output.AskSkipTagsOnLaunch
+	response.askJobTypeOnLaunch = output.AskJobTypeOnLaunch
+	response.askVerbosityOnLaunch = output.AskVerbosityOnLaunch
+	response.askInventoryOnLaunch = output.AskInventoryOnLaunch
+	response.askCredentialOnLaunch = output.AskCredentialOnLaunch
+	response.askExecutionEnvironmentOnLaunch = output.AskExecutionEnvironmentOnLaunch
+	response.askLabelsOnLaunch = output.AskLabelsOnLaunch
+	response.askForksOnLaunch = output.AskForksOnLaunch
+	response.askJobSliceCountOnLaunch = output.AskJobSliceCountOnLaunch
+	response.askTimeoutOnLaunch = output.AskTimeoutOnLaunch
+	response.askInstanceGroupsOnLaunch = output.AskInstanceGroupsOnLaunch
+	if output.Defaults != nil {
+		response.defaults = newJobTemplateLaunchDefaults(output.Defaults)
+	}
 	if output.JobTemplateData != nil {
-		response.jobTemplateData = new(JobTemplate)
-		response.jobTemplateData.id = output.JobTemplateData.Id
-		response.jobTemplateData.name = output.JobTemplateData.Name
+		response.jobTemplateData = newJobTemplate(&output.JobTemplateData.JobTemplate)
 	}
 	return
 }
 
 type JobTemplateLaunchGetResponse struct {
-	jobTemplateData *JobTemplate
+	canStartWithoutUserInput        bool
+	passwordsNeededToStart          []string
+	variablesNeededToStart          []string
+	inventoryNeededToStart          bool
+	credentialNeededToStart         bool
+	surveyEnabled                   bool
+	askSCMBranchOnLaunch            bool
+	askDiffModeOnLaunch             bool
+	askVarsOnLaunch                 bool
+	askLimitOnLaunch                bool
+	askTagsOnLaunch                 bool
+	askSkipTagsOnLaunch             bool
+	askJobTypeOnLaunch              bool
+	askVerbosityOnLaunch            bool
+	askInventoryOnLaunch            bool
+	askCredentialOnLaunch           bool
+	askExecutionEnvironmentOnLaunch bool
+	askLabelsOnLaunch               bool
+	askForksOnLaunch                bool
+	askJobSliceCountOnLaunch        bool
+	askTimeoutOnLaunch              bool
+	askInstanceGroupsOnLaunch       bool
+	defaults                        *JobTemplateLaunchDefaults
+	jobTemplateData                 *JobTemplate
+}
+
+// CanStartWithoutUserInput returns true if the job can be launched without giving any value.
+//
+func (r *JobTemplateLaunchGetResponse) CanStartWithoutUserInput() bool {
+	return r.canStartWithoutUserInput
+}
+
+// PasswordsNeededToStart returns the names of the credential passwords that must be given to
+// launch the job, for example 'ssh_password'.
+//
+func (r *JobTemplateLaunchGetResponse) PasswordsNeededToStart() []string {
+	return r.passwordsNeededToStart
+}
+
+// VariablesNeededToStart returns the names of the required survey variables that must be given as
+// extra variables to launch the job.
+//
+func (r *JobTemplateLaunchGetResponse) VariablesNeededToStart() []string {
+	return r.variablesNeededToStart
+}
+
+// InventoryNeededToStart returns true if the template doesn't have an inventory, so one must be
+// given to launch the job.
+//
+func (r *JobTemplateLaunchGetResponse) InventoryNeededToStart() bool {
+	return r.inventoryNeededToStart
+}
+
+// CredentialNeededToStart returns true if the template doesn't have credentials, so they must be
+// given to launch the job.
+//
+func (r *JobTemplateLaunchGetResponse) CredentialNeededToStart() bool {
+	return r.credentialNeededToStart
+}
+
+// SurveyEnabled returns true if the template has an enabled survey.
+//
+func (r *JobTemplateLaunchGetResponse) SurveyEnabled() bool {
+	return r.surveyEnabled
+}
+
+// AskSCMBranchOnLaunch returns true if the SCM branch can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskSCMBranchOnLaunch() bool {
+	return r.askSCMBranchOnLaunch
+}
+
+// AskDiffModeOnLaunch returns true if the diff mode can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskDiffModeOnLaunch() bool {
+	return r.askDiffModeOnLaunch
+}
+
+// AskVarsOnLaunch returns true if the extra variables can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskVarsOnLaunch() bool {
+	return r.askVarsOnLaunch
+}
+
+// AskLimitOnLaunch returns true if the limit can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskLimitOnLaunch() bool {
+	return r.askLimitOnLaunch
+}
+
+// AskTagsOnLaunch returns true if the job tags can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskTagsOnLaunch() bool {
+	return r.askTagsOnLaunch
+}
+
+// AskSkipTagsOnLaunch returns true if the skip tags can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskSkipTagsOnLaunch() bool {
+	return r.askSkipTagsOnLaunch
+}
+
+// AskJobTypeOnLaunch returns true if the job type can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskJobTypeOnLaunch() bool {
+	return r.askJobTypeOnLaunch
+}
+
+// AskVerbosityOnLaunch returns true if the verbosity can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskVerbosityOnLaunch() bool {
+	return r.askVerbosityOnLaunch
+}
+
+// AskInventoryOnLaunch returns true if the inventory can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskInventoryOnLaunch() bool {
+	return r.askInventoryOnLaunch
+}
+
+// AskCredentialOnLaunch returns true if the credentials can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskCredentialOnLaunch() bool {
+	return r.askCredentialOnLaunch
+}
+
+// AskExecutionEnvironmentOnLaunch returns true if the execution environment can be changed when
+// launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskExecutionEnvironmentOnLaunch() bool {
+	return r.askExecutionEnvironmentOnLaunch
+}
+
+// AskLabelsOnLaunch returns true if the labels can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskLabelsOnLaunch() bool {
+	return r.askLabelsOnLaunch
+}
+
+// AskForksOnLaunch returns true if the number of forks can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskForksOnLaunch() bool {
+	return r.askForksOnLaunch
+}
+
+// AskJobSliceCountOnLaunch returns true if the job slice count can be changed when launching the
+// job.
+//
+func (r *JobTemplateLaunchGetResponse) AskJobSliceCountOnLaunch() bool {
+	return r.askJobSliceCountOnLaunch
+}
+
+// AskTimeoutOnLaunch returns true if the timeout can be changed when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) AskTimeoutOnLaunch() bool {
+	return r.askTimeoutOnLaunch
+}
+
+// AskInstanceGroupsOnLaunch returns true if the instance groups can be changed when launching the
+// job.
+//
+func (r *JobTemplateLaunchGetResponse) AskInstanceGroupsOnLaunch() bool {
+	return r.askInstanceGroupsOnLaunch
+}
+
+// Defaults returns the values of the template that will be used for the fields that aren't given
+// when launching the job.
+//
+func (r *JobTemplateLaunchGetResponse) Defaults() *JobTemplateLaunchDefaults {
+	return r.defaults
 }
 
 func (r *JobTemplateLaunchGetResponse) JobTemplateData() *JobTemplate {
@@ -78,6 +275,7 @@ func (r *JobTemplateLaunchGetResponse) JobTemplateData() *JobTemplate {
 type JobTemplateLaunchPostRequest struct {
 	Request
 
+	input     data.JobTemplateLaunchPostRequest
 	extraVars map[string]interface{}
 	limit     string
 }
@@ -103,9 +301,209 @@ func (r *JobTemplateLaunchPostRequest) Limit(value string) *JobTemplateLaunchPos
 	return r
 }
 
+// Inventory sets the identifier of the inventory used by the job, instead of the one of the
+// template.
+func (r *JobTemplateLaunchPostRequest) Inventory(value int) *JobTemplateLaunchPostRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+// Credentials sets the complete list of identifiers of the credentials used by the job, instead of
+// the ones of the template.
+func (r *JobTemplateLaunchPostRequest) Credentials(value []int) *JobTemplateLaunchPostRequest {
+	r.input.Credentials = value
+	return r
+}
+
+// Credential adds a single credential identifier to the list of credentials used by the job.
+func (r *JobTemplateLaunchPostRequest) Credential(value int) *JobTemplateLaunchPostRequest {
+	r.input.Credentials = append(r.input.Credentials, value)
+	return r
+}
+
+// JobType sets the type of the job, either run or check.
+func (r *JobTemplateLaunchPostRequest) JobType(value JobType) *JobTemplateLaunchPostRequest {
+	jobType := string(value)
+	r.input.JobType = &jobType
+	return r
+}
+
+// JobTags sets the comma separated list of tags of the tasks to run.
+func (r *JobTemplateLaunchPostRequest) JobTags(value string) *JobTemplateLaunchPostRequest {
+	r.input.JobTags = &value
+	return r
+}
+
+// SkipTags sets the comma separated list of tags of the tasks to skip.
+func (r *JobTemplateLaunchPostRequest) SkipTags(value string) *JobTemplateLaunchPostRequest {
+	r.input.SkipTags = &value
+	return r
+}
+
+// Verbosity sets the verbosity level of the playbook output, from 0 (normal) to 5 (WinRM debug).
+func (r *JobTemplateLaunchPostRequest) Verbosity(value int) *JobTemplateLaunchPostRequest {
+	r.input.Verbosity = &value
+	return r
+}
+
+// DiffMode sets the flag that indicates if the job should show the changes made by the tasks.
+func (r *JobTemplateLaunchPostRequest) DiffMode(value bool) *JobTemplateLaunchPostRequest {
+	r.input.DiffMode = &value
+	return r
+}
+
+// SCMBranch sets the branch, tag or commit of the project used by the job.
+func (r *JobTemplateLaunchPostRequest) SCMBranch(value string) *JobTemplateLaunchPostRequest {
+	r.input.SCMBranch = &value
+	return r
+}
+
+// Forks sets the number of parallel processes used to run the playbook.
+func (r *JobTemplateLaunchPostRequest) Forks(value int) *JobTemplateLaunchPostRequest {
+	r.input.Forks = &value
+	return r
+}
+
+// Timeout sets the number of seconds that the job can run before being cancelled.
+func (r *JobTemplateLaunchPostRequest) Timeout(value int) *JobTemplateLaunchPostRequest {
+	r.input.Timeout = &value
+	return r
+}
+
+// JobSliceCount sets the number of slices the job is split into.
+func (r *JobTemplateLaunchPostRequest) JobSliceCount(value int) *JobTemplateLaunchPostRequest {
+	r.input.JobSliceCount = &value
+	return r
+}
+
+// ExecutionEnvironment sets the identifier of the execution environment used to run the job.
+func (r *JobTemplateLaunchPostRequest) ExecutionEnvironment(value int) *JobTemplateLaunchPostRequest {
+	r.input.ExecutionEnvironment = &value
+	return r
+}
+
+// Labels sets the complete list of identifiers of the labels added to the job.
+func (r *JobTemplateLaunchPostRequest) Labels(value []int) *JobTemplateLaunchPostRequest {
+	r.input.Labels = value
+	return r
+}
+
+// Label adds a single label identifier to the list of labels added to the job.
+func (r *JobTemplateLaunchPostRequest) Label(value int) *JobTemplateLaunchPostRequest {
+	r.input.Labels = append(r.input.Labels, value)
+	return r
+}
+
+// InstanceGroups sets the complete list of identifiers of the instance groups where the job can
+// run, in order of preference.
+func (r *JobTemplateLaunchPostRequest) InstanceGroups(value []int) *JobTemplateLaunchPostRequest {
+	r.input.InstanceGroups = value
+	return r
+}
+
+// InstanceGroup adds a single instance group identifier to the list of instance groups where the
+// job can run.
+func (r *JobTemplateLaunchPostRequest) InstanceGroup(value int) *JobTemplateLaunchPostRequest {
+	r.input.InstanceGroups = append(r.input.InstanceGroups, value)
+	return r
+}
+
+// CredentialPassword sets a password required by the credentials of the template, for example
+// 'ssh_password' or 'vault_password'. See the PasswordsNeededToStart method of the response to
+// the launch GET request.
+func (r *JobTemplateLaunchPostRequest) CredentialPassword(name string, value string) *JobTemplateLaunchPostRequest {
+	if r.input.CredentialPasswords == nil {
+		r.input.CredentialPasswords = make(map[string]string)
+	}
+	r.input.CredentialPasswords[name] = value
+	return r
+}
+
+// Validate retrieves the launch requirements of the template and checks that the request satisfies
+// them, without launching the job. If it doesn't the returned error is a
+// *JobTemplateLaunchValidationError describing the missing and disallowed fields.
+func (r *JobTemplateLaunchPostRequest) Validate() error {
+	return r.ValidateContext(context.Background())
+}
+
+func (r *JobTemplateLaunchPostRequest) ValidateContext(ctx context.Context) error {
+	request := new(JobTemplateLaunchGetRequest)
+	request.resource = r.resource
+	response, err := request.SendContext(ctx)
+	if err != nil {
+		return err
+	}
+	return r.validate(response)
+}
+
+func (r *JobTemplateLaunchPostRequest) validate(launch *JobTemplateLaunchGetResponse) error {
+	result := new(JobTemplateLaunchValidationError)
+
+	// Check that the fields that have been given can be changed on launch. Extra variables are
+	// also accepted when the template has a survey, as that is how the answers are given:
+	given := []struct {
+		name    string
+		set     bool
+		allowed bool
+	}{
+		{"extra_vars", r.extraVars != nil, launch.askVarsOnLaunch || launch.surveyEnabled},
+		{"limit", r.limit != "", launch.askLimitOnLaunch},
+		{"inventory", r.input.Inventory != nil, launch.askInventoryOnLaunch},
+		{"credentials", r.input.Credentials != nil, launch.askCredentialOnLaunch},
+		{"job_type", r.input.JobType != nil, launch.askJobTypeOnLaunch},
+		{"job_tags", r.input.JobTags != nil, launch.askTagsOnLaunch},
+		{"skip_tags", r.input.SkipTags != nil, launch.askSkipTagsOnLaunch},
+		{"verbosity", r.input.Verbosity != nil, launch.askVerbosityOnLaunch},
+		{"diff_mode", r.input.DiffMode != nil, launch.askDiffModeOnLaunch},
+		{"scm_branch", r.input.SCMBranch != nil, launch.askSCMBranchOnLaunch},
+		{"forks", r.input.Forks != nil, launch.askForksOnLaunch},
+		{"timeout", r.input.Timeout != nil, launch.askTimeoutOnLaunch},
+		{"job_slice_count", r.input.JobSliceCount != nil, launch.askJobSliceCountOnLaunch},
+		{"execution_environment", r.input.ExecutionEnvironment != nil, launch.askExecutionEnvironmentOnLaunch},
+		{"labels", r.input.Labels != nil, launch.askLabelsOnLaunch},
+		{"instance_groups", r.input.InstanceGroups != nil, launch.askInstanceGroupsOnLaunch},
+	}
+	for _, field := range given {
+		if field.set && !field.allowed {
+			result.disallowed = append(result.disallowed, field.name)
+		}
+	}
+
+	// Check that the inventory and the credentials have been given if the template doesn't
+	// have them:
+	if launch.inventoryNeededToStart && r.input.Inventory == nil {
+		result.missing = append(result.missing, "inventory")
+	}
+	if launch.credentialNeededToStart && len(r.input.Credentials) == 0 {
+		result.missing = append(result.missing, "credentials")
+	}
+
+	// Check that all the required passwords and survey variables have been given:
+	for _, password := range launch.passwordsNeededToStart {
+		if _, ok := r.input.CredentialPasswords[password]; !ok {
+			result.missing = append(result.missing, password)
+		}
+	}
+	for _, variable := range launch.variablesNeededToStart {
+		if _, ok := r.extraVars[variable]; !ok {
+			result.missing = append(result.missing, variable)
+		}
+	}
+
+	if len(result.missing) > 0 || len(result.disallowed) > 0 {
+		return result
+	}
+	return nil
+}
+
 func (r *JobTemplateLaunchPostRequest) Send() (response *JobTemplateLaunchPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobTemplateLaunchPostRequest) SendContext(ctx context.Context) (response *JobTemplateLaunchPostResponse, err error) {
 	// Generate the input data:
 	input := new(data.JobTemplateLaunchPostRequest)
+	*input = r.input
 
 	if r.extraVars != nil {
 		// convert extravars json to string
@@ -121,7 +519,7 @@ func (r *JobTemplateLaunchPostRequest) Send() (response *JobTemplateLaunchPostRe
 
 	// Send the request:
 	output := new(data.JobTemplateLaunchPostResponse)
-	err = r.post(input, output)
+	err = r.post(ctx, input, output)
 	if err != nil {
 		return
 	}
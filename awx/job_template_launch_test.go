@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for job template launch requests and their validation.
+
+package awx
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestLaunchValidateAllowed(t *testing.T) {
+	launch := &JobTemplateLaunchGetResponse{
+		askVarsOnLaunch:  true,
+		askLimitOnLaunch: true,
+	}
+	request := new(JobTemplateLaunchPostRequest).
+		ExtraVar("instance", "example.com").
+		Limit("master.example.com")
+	if err := request.validate(launch); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestLaunchValidateDisallowed(t *testing.T) {
+	launch := &JobTemplateLaunchGetResponse{
+		askLimitOnLaunch: true,
+	}
+	request := new(JobTemplateLaunchPostRequest).
+		ExtraVar("instance", "example.com").
+		Limit("master.example.com").
+		Verbosity(0).
+		DiffMode(false)
+	err := request.validate(launch)
+	validationErr, ok := err.(*JobTemplateLaunchValidationError)
+	if !ok {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	expected := []string{"extra_vars", "verbosity", "diff_mode"}
+	if !reflect.DeepEqual(validationErr.Disallowed(), expected) {
+		t.Errorf("Expected disallowed %v, got %v", expected, validationErr.Disallowed())
+	}
+	if len(validationErr.Missing()) != 0 {
+		t.Errorf("Expected no missing fields, got %v", validationErr.Missing())
+	}
+}
+
+func TestLaunchValidateSurvey(t *testing.T) {
+	launch := &JobTemplateLaunchGetResponse{
+		surveyEnabled:          true,
+		variablesNeededToStart: []string{"region", "size"},
+	}
+	request := new(JobTemplateLaunchPostRequest).
+		ExtraVar("region", "eu")
+	err := request.validate(launch)
+	validationErr, ok := err.(*JobTemplateLaunchValidationError)
+	if !ok {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	expected := []string{"size"}
+	if !reflect.DeepEqual(validationErr.Missing(), expected) {
+		t.Errorf("Expected missing %v, got %v", expected, validationErr.Missing())
+	}
+	if len(validationErr.Disallowed()) != 0 {
+		t.Errorf("Expected no disallowed fields, got %v", validationErr.Disallowed())
+	}
+}
+
+func TestLaunchValidateMissing(t *testing.T) {
+	launch := &JobTemplateLaunchGetResponse{
+		askInventoryOnLaunch:   true,
+		inventoryNeededToStart: true,
+		passwordsNeededToStart: []string{"ssh_password", "vault_password"},
+	}
+	request := new(JobTemplateLaunchPostRequest).
+		CredentialPassword("ssh_password", "secret")
+	err := request.validate(launch)
+	validationErr, ok := err.(*JobTemplateLaunchValidationError)
+	if !ok {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	expected := []string{"inventory", "vault_password"}
+	if !reflect.DeepEqual(validationErr.Missing(), expected) {
+		t.Errorf("Expected missing %v, got %v", expected, validationErr.Missing())
+	}
+
+	request.Inventory(3).CredentialPassword("vault_password", "secret")
+	if err := request.validate(launch); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestLaunchOverrides(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/job_templates/8/launch/", http.StatusCreated, `{
+		"job": 42,
+		"id": 42
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.JobTemplates().Id(8).Launch().Post().
+		ExtraVar("version", "1.0").
+		Limit("web").
+		Inventory(3).
+		Credential(5).
+		Credential(6).
+		JobType(JobTypeCheck).
+		JobTags("deploy").
+		SkipTags("slow").
+		Verbosity(0).
+		DiffMode(false).
+		SCMBranch("devel").
+		Forks(10).
+		Timeout(600).
+		JobSliceCount(2).
+		ExecutionEnvironment(1).
+		Label(11).
+		InstanceGroup(12).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/job_templates/8/launch/").body
+	extraVars := decodeVariablesField(t, body, "extra_vars")
+	if extraVars["version"] != "1.0" {
+		t.Errorf("Expected extra variable 'version' to be '1.0', got %v", extraVars)
+	}
+	delete(body, "extra_vars")
+
+	// Zero values explicitly given, like the verbosity and the diff mode, must be sent:
+	expected := map[string]interface{}{
+		"limit":                 "web",
+		"inventory":             3.0,
+		"credentials":           []interface{}{5.0, 6.0},
+		"job_type":              "check",
+		"job_tags":              "deploy",
+		"skip_tags":             "slow",
+		"verbosity":             0.0,
+		"diff_mode":             false,
+		"scm_branch":            "devel",
+		"forks":                 10.0,
+		"timeout":               600.0,
+		"job_slice_count":       2.0,
+		"execution_environment": 1.0,
+		"labels":                []interface{}{11.0},
+		"instance_groups":       []interface{}{12.0},
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+	if response.Job != 42 {
+		t.Errorf("Expected job 42, got %d", response.Job)
+	}
+}
+
+func TestLaunchOnlySendsGivenOverrides(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/job_templates/8/launch/", http.StatusCreated, `{"job": 42}`)
+	connection := server.connect(t)
+
+	_, err := connection.JobTemplates().Id(8).Launch().Post().
+		Limit("web").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/job_templates/8/launch/").body
+	expected := map[string]interface{}{
+		"limit": "web",
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+}
+
+func TestLaunchRejected(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(
+		http.MethodPost, "/api/v2/job_templates/8/launch/", http.StatusBadRequest,
+		`{"variables_needed_to_start": ["'region' value missing"]}`,
+	)
+	connection := server.connect(t)
+
+	response, err := connection.JobTemplates().Id(8).Launch().Post().Send()
+	if err == nil {
+		t.Errorf("Expected an error, got job %d", response.Job)
+	}
+}
@@ -20,6 +20,8 @@ limitations under the License.
 package awx
 
 import (
+	"context"
+
 	"github.com/moolitayer/awx-client-go/awx/internal/data"
 )
 
@@ -40,6 +42,18 @@ func (r *JobTemplateResource) Get() *JobTemplateGetRequest {
 	return request
 }
 
+func (r *JobTemplateResource) Patch() *JobTemplatePatchRequest {
+	request := new(JobTemplatePatchRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *JobTemplateResource) Delete() *JobTemplateDeleteRequest {
+	request := new(JobTemplateDeleteRequest)
+	request.resource = &r.Resource
+	return request
+}
+
 func (r *JobTemplateResource) Launch() *JobTemplateLaunchResource {
 	return NewJobTemplateLaunchResource(r.connection, r.path+"/launch")
 }
@@ -49,18 +63,17 @@ type JobTemplateGetRequest struct {
 }
 
 func (r *JobTemplateGetRequest) Send() (response *JobTemplateGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobTemplateGetRequest) SendContext(ctx context.Context) (response *JobTemplateGetResponse, err error) {
 	output := new(data.JobTemplateGetResponse)
-	err = r.get(output)
+	err = r.get(ctx, output)
 	if err != nil {
 		return
 	}
 	response = new(JobTemplateGetResponse)
-	response.result = new(JobTemplate)
-	response.result.id = output.Id
-	response.result.name = output.Name
-	response.result.askLimitOnLaunch = output.AskLimitOnLaunch
-	response.result.askVarsOnLaunch = output.AskVarsOnLaunch
-
+	response.result = newJobTemplate(&output.JobTemplate)
 	return
 }
 
@@ -71,3 +84,264 @@ type JobTemplateGetResponse struct {
 func (r *JobTemplateGetResponse) Result() *JobTemplate {
 	return r.result
 }
+
+// JobTemplatePatchRequest modifies the job template. Only the fields that are explicitly set are
+// sent to the server, the rest are left untouched.
+//
+type JobTemplatePatchRequest struct {
+	Request
+
+	input     data.JobTemplatePatchRequest
+	extraVars map[string]interface{}
+}
+
+func (r *JobTemplatePatchRequest) Name(value string) *JobTemplatePatchRequest {
+	r.input.Name = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Description(value string) *JobTemplatePatchRequest {
+	r.input.Description = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) JobType(value JobType) *JobTemplatePatchRequest {
+	converted := string(value)
+	r.input.JobType = &converted
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Inventory(value int) *JobTemplatePatchRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Project(value int) *JobTemplatePatchRequest {
+	r.input.Project = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Playbook(value string) *JobTemplatePatchRequest {
+	r.input.Playbook = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) SCMBranch(value string) *JobTemplatePatchRequest {
+	r.input.SCMBranch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Forks(value int) *JobTemplatePatchRequest {
+	r.input.Forks = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Limit(value string) *JobTemplatePatchRequest {
+	r.input.Limit = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Verbosity(value int) *JobTemplatePatchRequest {
+	r.input.Verbosity = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) JobTags(value string) *JobTemplatePatchRequest {
+	r.input.JobTags = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) SkipTags(value string) *JobTemplatePatchRequest {
+	r.input.SkipTags = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) StartAtTask(value string) *JobTemplatePatchRequest {
+	r.input.StartAtTask = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Timeout(value int) *JobTemplatePatchRequest {
+	r.input.Timeout = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) UseFactCache(value bool) *JobTemplatePatchRequest {
+	r.input.UseFactCache = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) HostConfigKey(value string) *JobTemplatePatchRequest {
+	r.input.HostConfigKey = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) DiffMode(value bool) *JobTemplatePatchRequest {
+	r.input.DiffMode = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) BecomeEnabled(value bool) *JobTemplatePatchRequest {
+	r.input.BecomeEnabled = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AllowSimultaneous(value bool) *JobTemplatePatchRequest {
+	r.input.AllowSimultaneous = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) SurveyEnabled(value bool) *JobTemplatePatchRequest {
+	r.input.SurveyEnabled = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) JobSliceCount(value int) *JobTemplatePatchRequest {
+	r.input.JobSliceCount = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) ExecutionEnvironment(value int) *JobTemplatePatchRequest {
+	r.input.ExecutionEnvironment = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskSCMBranchOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskSCMBranchOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskDiffModeOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskDiffModeOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskVarsOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskVarsOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskLimitOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskLimitOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskTagsOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskTagsOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskSkipTagsOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskSkipTagsOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskJobTypeOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskJobTypeOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskVerbosityOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskVerbosityOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskInventoryOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskInventoryOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskCredentialOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskCredentialOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskExecutionEnvironmentOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskExecutionEnvironmentOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskLabelsOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskLabelsOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskForksOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskForksOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskJobSliceCountOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskJobSliceCountOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskTimeoutOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskTimeoutOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) AskInstanceGroupsOnLaunch(value bool) *JobTemplatePatchRequest {
+	r.input.AskInstanceGroupsOnLaunch = &value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) ExtraVars(value map[string]interface{}) *JobTemplatePatchRequest {
+	r.extraVars = value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) ExtraVar(name string, value interface{}) *JobTemplatePatchRequest {
+	if r.extraVars == nil {
+		r.extraVars = make(map[string]interface{})
+	}
+	r.extraVars[name] = value
+	return r
+}
+
+func (r *JobTemplatePatchRequest) Send() (response *JobTemplatePatchResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobTemplatePatchRequest) SendContext(ctx context.Context) (response *JobTemplatePatchResponse, err error) {
+	r.input.ExtraVars, err = encodeVariables(r.extraVars)
+	if err != nil {
+		return
+	}
+	output := new(data.JobTemplatePatchResponse)
+	err = r.patch(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(JobTemplatePatchResponse)
+	response.result = newJobTemplate(&output.JobTemplate)
+	return
+}
+
+type JobTemplatePatchResponse struct {
+	result *JobTemplate
+}
+
+func (r *JobTemplatePatchResponse) Result() *JobTemplate {
+	return r.result
+}
+
+type JobTemplateDeleteRequest struct {
+	Request
+}
+
+func (r *JobTemplateDeleteRequest) Send() (response *JobTemplateDeleteResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobTemplateDeleteRequest) SendContext(ctx context.Context) (response *JobTemplateDeleteResponse, err error) {
+	err = r.delete(ctx)
+	if err != nil {
+		return
+	}
+	response = new(JobTemplateDeleteResponse)
+	return
+}
+
+type JobTemplateDeleteResponse struct {
+}
@@ -0,0 +1,211 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the job templates resources.
+
+package awx
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJobTemplatesGetFilter(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/job_templates/", http.StatusOK, `{
+		"count": 1,
+		"results": [{
+			"id": 8,
+			"name": "mytemplate",
+			"job_type": "run",
+			"inventory": 3,
+			"project": 4,
+			"playbook": "site.yml",
+			"forks": 5,
+			"verbosity": 1,
+			"timeout": 300,
+			"become_enabled": true,
+			"survey_enabled": true,
+			"job_slice_count": 2,
+			"ask_inventory_on_launch": true,
+			"ask_variables_on_launch": true,
+			"status": "failed",
+			"last_job_run": "2018-05-09T10:04:35Z",
+			"last_job_failed": true
+		}]
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.JobTemplates().Get().
+		Filter("project", 4).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := server.last(t, http.MethodGet, "/api/v2/job_templates/").query
+	if query.Get("project") != "4" {
+		t.Errorf("Expected the project filter, got '%s'", query.Encode())
+	}
+	results := response.Results()
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 job template, got %d", len(results))
+	}
+	template := results[0]
+	if template.JobType() != JobTypeRun || template.Inventory() != 3 || template.Project() != 4 {
+		t.Errorf("Unexpected job template %+v", template)
+	}
+	if template.Playbook() != "site.yml" || template.Forks() != 5 || template.Verbosity() != 1 {
+		t.Errorf("Unexpected job template %+v", template)
+	}
+	if template.Timeout() != 300 || !template.BecomeEnabled() || !template.SurveyEnabled() {
+		t.Errorf("Unexpected job template %+v", template)
+	}
+	if template.JobSliceCount() != 2 || !template.AskInventoryOnLaunch() || !template.AskVarsOnLaunch() {
+		t.Errorf("Unexpected job template %+v", template)
+	}
+	if template.AskLimitOnLaunch() || template.AskTagsOnLaunch() {
+		t.Errorf("Unexpected job template %+v", template)
+	}
+	if template.Status() != JobStatusFailed || !template.LastJobFailed() {
+		t.Errorf("Unexpected job template %+v", template)
+	}
+	expected := time.Date(2018, 5, 9, 10, 4, 35, 0, time.UTC)
+	if !template.LastJobRun().Equal(expected) {
+		t.Errorf("Expected last job run %s, got %s", expected, template.LastJobRun())
+	}
+}
+
+func TestJobTemplatesPost(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/job_templates/", http.StatusCreated, `{
+		"id": 8,
+		"name": "mytemplate",
+		"job_type": "check",
+		"extra_vars": "{\"version\": 2}"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.JobTemplates().Post().
+		Name("mytemplate").
+		JobType(JobTypeCheck).
+		Inventory(3).
+		Project(4).
+		Playbook("site.yml").
+		Forks(5).
+		DiffMode(false).
+		AskLimitOnLaunch(true).
+		AskVarsOnLaunch(true).
+		ExtraVar("version", 2).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/job_templates/").body
+	if body["name"] != "mytemplate" || body["job_type"] != "check" || body["playbook"] != "site.yml" {
+		t.Errorf("Expected the name, job type and playbook, got %v", body)
+	}
+	if body["inventory"] != 3.0 || body["project"] != 4.0 || body["forks"] != 5.0 {
+		t.Errorf("Expected the inventory, project and forks, got %v", body)
+	}
+	if body["ask_limit_on_launch"] != true || body["ask_variables_on_launch"] != true {
+		t.Errorf("Expected the ask on launch flags, got %v", body)
+	}
+
+	// Flags explicitly set to false must be sent, as the server may have a different default:
+	if body["diff_mode"] != false {
+		t.Errorf("Expected the diff mode flag to be false, got %v", body)
+	}
+	for _, field := range []string{"limit", "verbosity", "become_enabled", "ask_tags_on_launch"} {
+		if _, ok := body[field]; ok {
+			t.Errorf("Expected no '%s' field, got %v", field, body)
+		}
+	}
+	extraVars := decodeVariablesField(t, body, "extra_vars")
+	if extraVars["version"] != 2.0 {
+		t.Errorf("Expected extra variable 'version' to be 2, got %v", extraVars)
+	}
+	template := response.Result()
+	if template.Id() != 8 || template.JobType() != JobTypeCheck {
+		t.Errorf("Unexpected job template %+v", template)
+	}
+	if template.ExtraVars() != `{"version": 2}` {
+		t.Errorf("Unexpected extra variables '%s'", template.ExtraVars())
+	}
+}
+
+func TestJobTemplatePatchSendsOnlyChanges(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPatch, "/api/v2/job_templates/8/", http.StatusOK, `{
+		"id": 8,
+		"limit": "web",
+		"verbosity": 2
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.JobTemplates().Id(8).Patch().
+		Limit("web").
+		Verbosity(2).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPatch, "/api/v2/job_templates/8/").body
+	expected := map[string]interface{}{
+		"limit":     "web",
+		"verbosity": 2.0,
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+	if response.Result().Limit() != "web" || response.Result().Verbosity() != 2 {
+		t.Errorf("Unexpected job template %+v", response.Result())
+	}
+}
+
+func TestJobTemplateDelete(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodDelete, "/api/v2/job_templates/8/", http.StatusNoContent, "")
+	connection := server.connect(t)
+
+	_, err := connection.JobTemplates().Id(8).Delete().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.count(http.MethodDelete, "/api/v2/job_templates/8/") != 1 {
+		t.Errorf("Expected the job template to be deleted, got %v", server.recorded())
+	}
+}
+
+func TestJobTemplateDeleteConflict(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(
+		http.MethodDelete, "/api/v2/job_templates/8/", http.StatusConflict,
+		`{"error": "Resource is being used by running jobs.", "active_jobs": []}`,
+	)
+	connection := server.connect(t)
+
+	_, err := connection.JobTemplates().Id(8).Delete().Send()
+	if err == nil {
+		t.Errorf("Expected the deletion to fail")
+	}
+}
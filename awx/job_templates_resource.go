@@ -20,6 +20,7 @@ limitations under the License.
 package awx
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/moolitayer/awx-client-go/awx/internal/data"
@@ -42,6 +43,12 @@ func (r *JobTemplatesResource) Get() *JobTemplatesGetRequest {
 	return request
 }
 
+func (r *JobTemplatesResource) Post() *JobTemplatesPostRequest {
+	request := new(JobTemplatesPostRequest)
+	request.resource = &r.Resource
+	return request
+}
+
 func (r *JobTemplatesResource) Id(id int) *JobTemplateResource {
 	return NewJobTemplateResource(r.connection, fmt.Sprintf("%s/%d", r.path, id))
 }
@@ -55,9 +62,60 @@ func (r *JobTemplatesGetRequest) Filter(name string, value interface{}) *JobTemp
 	return r
 }
 
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *JobTemplatesGetRequest) PageSize(value int) *JobTemplatesGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the job templates.
+// It stops and returns the error if the function returns an error.
+//
+func (r *JobTemplatesGetRequest) Each(f func(jobTemplate *JobTemplate) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *JobTemplatesGetRequest) EachContext(ctx context.Context, f func(jobTemplate *JobTemplate) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, jobTemplate := range response.results {
+			err = f(jobTemplate)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the job templates.
+//
+func (r *JobTemplatesGetRequest) All() (results []*JobTemplate, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *JobTemplatesGetRequest) AllContext(ctx context.Context) (results []*JobTemplate, err error) {
+	err = r.EachContext(ctx, func(jobTemplate *JobTemplate) error {
+		results = append(results, jobTemplate)
+		return nil
+	})
+	return
+}
+
 func (r *JobTemplatesGetRequest) Send() (response *JobTemplatesGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobTemplatesGetRequest) SendContext(ctx context.Context) (response *JobTemplatesGetResponse, err error) {
 	output := new(data.JobTemplatesGetResponse)
-	err = r.get(output)
+	err = r.get(ctx, output)
 	if err != nil {
 		return
 	}
@@ -65,13 +123,11 @@ func (r *JobTemplatesGetRequest) Send() (response *JobTemplatesGetResponse, err
 	response.count = output.Count
 	response.previous = output.Previous
 	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
 	response.results = make([]*JobTemplate, len(output.Results))
 	for i := 0; i < len(output.Results); i++ {
-		response.results[i] = new(JobTemplate)
-		response.results[i].id = output.Results[i].Id
-		response.results[i].name = output.Results[i].Name
-		response.results[i].askLimitOnLaunch = output.Results[i].AskLimitOnLaunch
-		response.results[i].askVarsOnLaunch = output.Results[i].AskVarsOnLaunch
+		response.results[i] = newJobTemplate(output.Results[i])
 	}
 	return
 }
@@ -85,3 +141,355 @@ type JobTemplatesGetResponse struct {
 func (r *JobTemplatesGetResponse) Results() []*JobTemplate {
 	return r.results
 }
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *JobTemplatesGetResponse) NextPage() (response *JobTemplatesGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(JobTemplatesGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
+
+type JobTemplatesPostRequest struct {
+	Request
+
+	input     data.JobTemplatePostRequest
+	extraVars map[string]interface{}
+}
+
+// Name sets the name of the job template. It is mandatory.
+//
+func (r *JobTemplatesPostRequest) Name(value string) *JobTemplatesPostRequest {
+	r.input.Name = &value
+	return r
+}
+
+// Description sets the description of the job template.
+//
+func (r *JobTemplatesPostRequest) Description(value string) *JobTemplatesPostRequest {
+	r.input.Description = &value
+	return r
+}
+
+// JobType sets the type of the jobs launched from the template, either run or check.
+//
+func (r *JobTemplatesPostRequest) JobType(value JobType) *JobTemplatesPostRequest {
+	converted := string(value)
+	r.input.JobType = &converted
+	return r
+}
+
+// Inventory sets the identifier of the inventory used by the template.
+//
+func (r *JobTemplatesPostRequest) Inventory(value int) *JobTemplatesPostRequest {
+	r.input.Inventory = &value
+	return r
+}
+
+// Project sets the identifier of the project that contains the playbook. It is mandatory.
+//
+func (r *JobTemplatesPostRequest) Project(value int) *JobTemplatesPostRequest {
+	r.input.Project = &value
+	return r
+}
+
+// Playbook sets the name of the playbook, relative to the project directory. It is mandatory.
+//
+func (r *JobTemplatesPostRequest) Playbook(value string) *JobTemplatesPostRequest {
+	r.input.Playbook = &value
+	return r
+}
+
+// SCMBranch sets the branch of the project used by the template. The project must allow overriding
+// it.
+//
+func (r *JobTemplatesPostRequest) SCMBranch(value string) *JobTemplatesPostRequest {
+	r.input.SCMBranch = &value
+	return r
+}
+
+// Forks sets the number of parallel processes used to run the playbook.
+//
+func (r *JobTemplatesPostRequest) Forks(value int) *JobTemplatesPostRequest {
+	r.input.Forks = &value
+	return r
+}
+
+// Limit sets the host pattern that restricts the hosts the playbook runs on.
+//
+func (r *JobTemplatesPostRequest) Limit(value string) *JobTemplatesPostRequest {
+	r.input.Limit = &value
+	return r
+}
+
+// Verbosity sets the verbosity level of the playbook output, from 0 (normal) to 5 (WinRM debug).
+//
+func (r *JobTemplatesPostRequest) Verbosity(value int) *JobTemplatesPostRequest {
+	r.input.Verbosity = &value
+	return r
+}
+
+// JobTags sets the comma separated list of tags of the tasks to run.
+//
+func (r *JobTemplatesPostRequest) JobTags(value string) *JobTemplatesPostRequest {
+	r.input.JobTags = &value
+	return r
+}
+
+// SkipTags sets the comma separated list of tags of the tasks to skip.
+//
+func (r *JobTemplatesPostRequest) SkipTags(value string) *JobTemplatesPostRequest {
+	r.input.SkipTags = &value
+	return r
+}
+
+// StartAtTask sets the name of the task where the playbook starts.
+//
+func (r *JobTemplatesPostRequest) StartAtTask(value string) *JobTemplatesPostRequest {
+	r.input.StartAtTask = &value
+	return r
+}
+
+// Timeout sets the number of seconds that the job can run before being cancelled.
+//
+func (r *JobTemplatesPostRequest) Timeout(value int) *JobTemplatesPostRequest {
+	r.input.Timeout = &value
+	return r
+}
+
+// UseFactCache sets the flag that indicates if the facts gathered by the jobs should be stored.
+//
+func (r *JobTemplatesPostRequest) UseFactCache(value bool) *JobTemplatesPostRequest {
+	r.input.UseFactCache = &value
+	return r
+}
+
+// HostConfigKey sets the key that allows hosts to request a provisioning callback.
+//
+func (r *JobTemplatesPostRequest) HostConfigKey(value string) *JobTemplatesPostRequest {
+	r.input.HostConfigKey = &value
+	return r
+}
+
+// DiffMode sets the flag that indicates if the jobs should show the changes made by the tasks.
+//
+func (r *JobTemplatesPostRequest) DiffMode(value bool) *JobTemplatesPostRequest {
+	r.input.DiffMode = &value
+	return r
+}
+
+// BecomeEnabled sets the flag that indicates if the playbook should run with privilege escalation.
+//
+func (r *JobTemplatesPostRequest) BecomeEnabled(value bool) *JobTemplatesPostRequest {
+	r.input.BecomeEnabled = &value
+	return r
+}
+
+// AllowSimultaneous sets the flag that indicates if several jobs launched from the template can run
+// at the same time.
+//
+func (r *JobTemplatesPostRequest) AllowSimultaneous(value bool) *JobTemplatesPostRequest {
+	r.input.AllowSimultaneous = &value
+	return r
+}
+
+// SurveyEnabled sets the flag that indicates if the survey of the template should be presented on
+// launch.
+//
+func (r *JobTemplatesPostRequest) SurveyEnabled(value bool) *JobTemplatesPostRequest {
+	r.input.SurveyEnabled = &value
+	return r
+}
+
+// JobSliceCount sets the number of slices the jobs launched from the template are split into.
+//
+func (r *JobTemplatesPostRequest) JobSliceCount(value int) *JobTemplatesPostRequest {
+	r.input.JobSliceCount = &value
+	return r
+}
+
+// ExecutionEnvironment sets the identifier of the execution environment used to run the jobs.
+//
+func (r *JobTemplatesPostRequest) ExecutionEnvironment(value int) *JobTemplatesPostRequest {
+	r.input.ExecutionEnvironment = &value
+	return r
+}
+
+// AskSCMBranchOnLaunch sets the flag that indicates if the SCM branch can be changed when launching
+// a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskSCMBranchOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskSCMBranchOnLaunch = &value
+	return r
+}
+
+// AskDiffModeOnLaunch sets the flag that indicates if the diff mode can be changed when launching a
+// job from the template.
+//
+func (r *JobTemplatesPostRequest) AskDiffModeOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskDiffModeOnLaunch = &value
+	return r
+}
+
+// AskVarsOnLaunch sets the flag that indicates if the extra variables can be changed when launching
+// a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskVarsOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskVarsOnLaunch = &value
+	return r
+}
+
+// AskLimitOnLaunch sets the flag that indicates if the limit can be changed when launching a job
+// from the template.
+//
+func (r *JobTemplatesPostRequest) AskLimitOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskLimitOnLaunch = &value
+	return r
+}
+
+// AskTagsOnLaunch sets the flag that indicates if the job tags can be changed when launching a job
+// from the template.
+//
+func (r *JobTemplatesPostRequest) AskTagsOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskTagsOnLaunch = &value
+	return r
+}
+
+// AskSkipTagsOnLaunch sets the flag that indicates if the skip tags can be changed when launching a
+// job from the template.
+//
+func (r *JobTemplatesPostRequest) AskSkipTagsOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskSkipTagsOnLaunch = &value
+	return r
+}
+
+// AskJobTypeOnLaunch sets the flag that indicates if the job type can be changed when launching a
+// job from the template.
+//
+func (r *JobTemplatesPostRequest) AskJobTypeOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskJobTypeOnLaunch = &value
+	return r
+}
+
+// AskVerbosityOnLaunch sets the flag that indicates if the verbosity can be changed when launching
+// a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskVerbosityOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskVerbosityOnLaunch = &value
+	return r
+}
+
+// AskInventoryOnLaunch sets the flag that indicates if the inventory can be changed when launching
+// a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskInventoryOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskInventoryOnLaunch = &value
+	return r
+}
+
+// AskCredentialOnLaunch sets the flag that indicates if the credentials can be changed when
+// launching a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskCredentialOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskCredentialOnLaunch = &value
+	return r
+}
+
+// AskExecutionEnvironmentOnLaunch sets the flag that indicates if the execution environment can be
+// changed when launching a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskExecutionEnvironmentOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskExecutionEnvironmentOnLaunch = &value
+	return r
+}
+
+// AskLabelsOnLaunch sets the flag that indicates if the labels can be changed when launching a job
+// from the template.
+//
+func (r *JobTemplatesPostRequest) AskLabelsOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskLabelsOnLaunch = &value
+	return r
+}
+
+// AskForksOnLaunch sets the flag that indicates if the number of forks can be changed when
+// launching a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskForksOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskForksOnLaunch = &value
+	return r
+}
+
+// AskJobSliceCountOnLaunch sets the flag that indicates if the job slice count can be changed when
+// launching a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskJobSliceCountOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskJobSliceCountOnLaunch = &value
+	return r
+}
+
+// AskTimeoutOnLaunch sets the flag that indicates if the timeout can be changed when launching a
+// job from the template.
+//
+func (r *JobTemplatesPostRequest) AskTimeoutOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskTimeoutOnLaunch = &value
+	return r
+}
+
+// AskInstanceGroupsOnLaunch sets the flag that indicates if the instance groups can be changed when
+// launching a job from the template.
+//
+func (r *JobTemplatesPostRequest) AskInstanceGroupsOnLaunch(value bool) *JobTemplatesPostRequest {
+	r.input.AskInstanceGroupsOnLaunch = &value
+	return r
+}
+
+// ExtraVars sets the complete map of extra variables of the job template.
+//
+func (r *JobTemplatesPostRequest) ExtraVars(value map[string]interface{}) *JobTemplatesPostRequest {
+	r.extraVars = value
+	return r
+}
+
+// ExtraVar adds a single extra variable to the job template.
+//
+func (r *JobTemplatesPostRequest) ExtraVar(name string, value interface{}) *JobTemplatesPostRequest {
+	if r.extraVars == nil {
+		r.extraVars = make(map[string]interface{})
+	}
+	r.extraVars[name] = value
+	return r
+}
+
+func (r *JobTemplatesPostRequest) Send() (response *JobTemplatesPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobTemplatesPostRequest) SendContext(ctx context.Context) (response *JobTemplatesPostResponse, err error) {
+	r.input.ExtraVars, err = encodeVariables(r.extraVars)
+	if err != nil {
+		return
+	}
+	output := new(data.JobTemplatePostResponse)
+	err = r.post(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(JobTemplatesPostResponse)
+	response.result = newJobTemplate(&output.JobTemplate)
+	return
+}
+
+type JobTemplatesPostResponse struct {
+	result *JobTemplate
+}
+
+func (r *JobTemplatesPostResponse) Result() *JobTemplate {
+	return r.result
+}
@@ -18,18 +18,26 @@ limitations under the License.
 
 package awx
 
-import "testing"
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
 
 func TestIsSuccessful(t *testing.T) {
 	for _, status := range []JobStatus{
 		JobStatusNew, JobStatusPending, JobStatusWaiting, JobStatusRunning,
 		JobStatusFailed, JobStatusError, JobStatusCancelled,
 	} {
-		if (&Job{0, status}).IsSuccessful() {
+		if (&Job{status: status}).IsSuccessful() {
 			t.Errorf("Job.IsSuccessful() Should return false for %s", status)
 		}
 	}
-	if !(&Job{0, JobStatusSuccesful}).IsSuccessful() {
+	if !(&Job{status: JobStatusSuccesful}).IsSuccessful() {
 		t.Errorf("Job.IsSuccessful() Should return true for JobStatusSuccesful")
 	}
 }
@@ -38,15 +46,132 @@ func TestIsFinished(t *testing.T) {
 	for _, status := range []JobStatus{
 		JobStatusNew, JobStatusPending, JobStatusWaiting, JobStatusRunning,
 	} {
-		if (&Job{0, status}).IsFinished() {
+		if (&Job{status: status}).IsFinished() {
 			t.Errorf("Job.IsFinished() Should return false for %s", status)
 		}
 	}
 	for _, status := range []JobStatus{
 		JobStatusSuccesful, JobStatusFailed, JobStatusError, JobStatusCancelled,
 	} {
-		if !(&Job{0, status}).IsFinished() {
+		if !(&Job{status: status}).IsFinished() {
 			t.Errorf("Job.IsFinished() Should return false for %s", status)
 		}
 	}
 }
+
+func TestNewJob(t *testing.T) {
+	input := []byte(`{
+		"id": 7,
+		"status": "failed",
+		"created": "2018-05-09T10:04:35.123456Z",
+		"started": "2018-05-09T10:04:36Z",
+		"finished": null,
+		"elapsed": 12.5,
+		"failed": true,
+		"extra_vars": "{\"instance\": \"example.com\"}",
+		"launched_by": {"id": 1, "name": "admin", "type": "user"}
+	}`)
+	output := new(data.Job)
+	if err := json.Unmarshal(input, output); err != nil {
+		t.Fatal(err)
+	}
+	job := newJob(output)
+	if job.Elapsed() != 12500*time.Millisecond {
+		t.Errorf("Expected elapsed 12.5s, got %s", job.Elapsed())
+	}
+	if job.Started().IsZero() || !job.Finished().IsZero() {
+		t.Errorf("Expected started to be set and finished to be zero, got %s and %s",
+			job.Started(), job.Finished())
+	}
+	if job.ExtraVars()["instance"] != "example.com" {
+		t.Errorf("Expected extra var 'instance' to be 'example.com', got %v", job.ExtraVars())
+	}
+	if job.LaunchedBy() == nil || job.LaunchedBy().Name() != "admin" {
+		t.Errorf("Expected the job to be launched by 'admin', got %v", job.LaunchedBy())
+	}
+}
+
+func TestJobCancel(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/jobs/42/cancel/", http.StatusOK, `{"can_cancel": true}`)
+	server.respond(http.MethodPost, "/api/v2/jobs/42/cancel/", http.StatusAccepted, "")
+	connection := server.connect(t)
+
+	_, err := connection.Jobs().Id(42).Cancel().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.count(http.MethodGet, "/api/v2/jobs/42/cancel/") != 1 {
+		t.Errorf("Expected the job to be checked before cancelling it, got %v", server.recorded())
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/jobs/42/cancel/").body
+	if len(body) != 0 {
+		t.Errorf("Expected an empty body, got %v", body)
+	}
+}
+
+func TestJobCancelFinished(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/jobs/42/cancel/", http.StatusOK, `{"can_cancel": false}`)
+	connection := server.connect(t)
+
+	_, err := connection.Jobs().Id(42).Cancel().Send()
+	if err == nil {
+		t.Fatalf("Expected an error when the job can't be cancelled")
+	}
+	if server.count(http.MethodPost, "/api/v2/jobs/42/cancel/") != 0 {
+		t.Errorf("Expected no cancel request, got %v", server.recorded())
+	}
+}
+
+func TestJobRelaunch(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/jobs/42/relaunch/", http.StatusCreated, `{
+		"job": 43,
+		"status": "pending"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Jobs().Id(42).Relaunch().
+		Hosts(RelaunchHostsFailed).
+		CredentialPassword("ssh_password", "secret").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/jobs/42/relaunch/").body
+	if body["hosts"] != "failed" {
+		t.Errorf("Expected to relaunch on the failed hosts, got %v", body)
+	}
+	passwords := map[string]interface{}{
+		"ssh_password": "secret",
+	}
+	if !reflect.DeepEqual(body["credential_passwords"], passwords) {
+		t.Errorf("Expected credential passwords %v, got %v", passwords, body["credential_passwords"])
+	}
+	job := response.Job()
+	if job.Id() != 43 || job.Status() != JobStatusPending {
+		t.Errorf("Unexpected job %+v", job)
+	}
+}
+
+func TestJobRelaunchDefaults(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/jobs/42/relaunch/", http.StatusCreated, `{"job": 43}`)
+	connection := server.connect(t)
+
+	_, err := connection.Jobs().Id(42).Relaunch().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The server decides the hosts when they aren't given, so nothing should be sent:
+	body := server.last(t, http.MethodPost, "/api/v2/jobs/42/relaunch/").body
+	if len(body) != 0 {
+		t.Errorf("Expected an empty body, got %v", body)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the method that waits for a job to finish.
+
+package awx
+
+import (
+	"context"
+	"time"
+)
+
+// JobWaitOptions controls how the Wait method of a job resource polls the server. The zero value
+// uses the defaults described for each field.
+//
+type JobWaitOptions struct {
+	// Interval is the time to wait after the first poll. The default is one second.
+	Interval time.Duration
+
+	// MaxInterval is the maximum time to wait between polls. The default is thirty seconds.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor applied to the interval after each poll, so that long running jobs
+	// are polled less frequently. The default is 1.5. Use 1 to poll at a fixed interval.
+	Multiplier float64
+
+	// Timeout is the maximum total time to wait. The default is to wait until the job finishes
+	// or the context is cancelled.
+	Timeout time.Duration
+
+	// OnStatusChange, if not nil, is called each time the job is found in a status different
+	// than the previous one, including the first time it is retrieved, when previous is empty.
+	OnStatusChange func(job *Job, previous JobStatus)
+}
+
+// Wait polls the job till it finishes, the context is cancelled or the timeout of the options
+// expires. It returns the last version of the job retrieved. If waiting stopped because of the
+// context or the timeout the error will be the one returned by the Err method of the context. The
+// options can be nil, to use the defaults.
+//
+func (r *JobResource) Wait(ctx context.Context, opts *JobWaitOptions) (job *Job, err error) {
+	// Apply the defaults:
+	interval := time.Second
+	maxInterval := 30 * time.Second
+	multiplier := 1.5
+	var onStatusChange func(*Job, JobStatus)
+	if opts != nil {
+		if opts.Interval > 0 {
+			interval = opts.Interval
+		}
+		if opts.MaxInterval > 0 {
+			maxInterval = opts.MaxInterval
+		}
+		if opts.Multiplier >= 1 {
+			multiplier = opts.Multiplier
+		}
+		if opts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			defer cancel()
+		}
+		onStatusChange = opts.OnStatusChange
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	var status JobStatus
+	for {
+		var response *JobGetResponse
+		response, err = r.Get().SendContext(ctx)
+		if err != nil {
+			// Report the cancellation of the context as such, and not as a failure of the
+			// request that was in progress:
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			return
+		}
+		job = response.Job()
+		if job.status != status {
+			if onStatusChange != nil {
+				onStatusChange(job, status)
+			}
+			status = job.status
+		}
+		if job.IsFinished() {
+			return
+		}
+
+		// Wait till the next poll:
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = ctx.Err()
+			return
+		case <-timer.C:
+		}
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for waiting for jobs.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newJobStatusServer creates a server that responds to the requests for the job with identifier 1
+// with the given statuses, one per request, repeating the last one when exhausted.
+func newJobStatusServer(statuses ...JobStatus) *httptest.Server {
+	count := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[len(statuses)-1]
+		if count < len(statuses) {
+			status = statuses[count]
+		}
+		count++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": 1, "status": "%s"}`, status)
+	}))
+}
+
+func TestJobWait(t *testing.T) {
+	server := newJobStatusServer(JobStatusPending, JobStatusRunning, JobStatusRunning, JobStatusSuccesful)
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	var transitions []JobStatus
+	job, err := connection.Jobs().Id(1).Wait(context.Background(), &JobWaitOptions{
+		Interval: time.Millisecond,
+		OnStatusChange: func(job *Job, previous JobStatus) {
+			transitions = append(transitions, job.Status())
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !job.IsSuccessful() {
+		t.Errorf("Expected the job to be successful, got %s", job.Status())
+	}
+	expected := []JobStatus{JobStatusPending, JobStatusRunning, JobStatusSuccesful}
+	if !reflect.DeepEqual(transitions, expected) {
+		t.Errorf("Expected transitions %v, got %v", expected, transitions)
+	}
+}
+
+func TestJobWaitTimeout(t *testing.T) {
+	server := newJobStatusServer(JobStatusRunning)
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	job, err := connection.Jobs().Id(1).Wait(context.Background(), &JobWaitOptions{
+		Interval: time.Millisecond,
+		Timeout:  20 * time.Millisecond,
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected deadline exceeded error, got %v", err)
+	}
+	if job == nil || job.Status() != JobStatusRunning {
+		t.Errorf("Expected the last retrieved job to be running, got %v", job)
+	}
+}
@@ -20,6 +20,7 @@ limitations under the License.
 package awx
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/moolitayer/awx-client-go/awx/internal/data"
@@ -55,9 +56,60 @@ func (r *JobsGetRequest) Filter(name string, value interface{}) *JobsGetRequest
 	return r
 }
 
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *JobsGetRequest) PageSize(value int) *JobsGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the jobs.
+// It stops and returns the error if the function returns an error.
+//
+func (r *JobsGetRequest) Each(f func(job *Job) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *JobsGetRequest) EachContext(ctx context.Context, f func(job *Job) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, job := range response.results {
+			err = f(job)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the jobs.
+//
+func (r *JobsGetRequest) All() (results []*Job, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *JobsGetRequest) AllContext(ctx context.Context) (results []*Job, err error) {
+	err = r.EachContext(ctx, func(job *Job) error {
+		results = append(results, job)
+		return nil
+	})
+	return
+}
+
 func (r *JobsGetRequest) Send() (response *JobsGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *JobsGetRequest) SendContext(ctx context.Context) (response *JobsGetResponse, err error) {
 	output := new(data.JobsGetResponse)
-	err = r.get(output)
+	err = r.get(ctx, output)
 	if err != nil {
 		return
 	}
@@ -65,11 +117,11 @@ func (r *JobsGetRequest) Send() (response *JobsGetResponse, err error) {
 	response.count = output.Count
 	response.previous = output.Previous
 	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
 	response.results = make([]*Job, len(output.Results))
-	for i, result := range response.results {
-		result = new(Job)
-		result.id = output.Results[i].Id
-		result.status = (JobStatus)(output.Results[i].Status)
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newJob(output.Results[i])
 	}
 	return
 }
@@ -83,3 +135,18 @@ type JobsGetResponse struct {
 func (r *JobsGetResponse) Results() []*Job {
 	return r.results
 }
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *JobsGetResponse) NextPage() (response *JobsGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(JobsGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
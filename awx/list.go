@@ -18,12 +18,59 @@ limitations under the License.
 
 package awx
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
 type ListGetResponse struct {
 	count    int
 	next     string
 	previous string
+
+	// The resource and context that were used to retrieve this page, needed to retrieve the next
+	// one.
+	resource *Resource
+	ctx      context.Context
 }
 
+// Count returns the total number of results, in all the pages.
+//
 func (r *ListGetResponse) Count() int {
 	return r.count
 }
+
+// Next returns the link to the next page of results, or an empty string if this is the last page.
+//
+func (r *ListGetResponse) Next() string {
+	return r.next
+}
+
+// Previous returns the link to the previous page of results, or an empty string if this is the
+// first page.
+//
+func (r *ListGetResponse) Previous() string {
+	return r.previous
+}
+
+// HasNext returns true if there are more pages of results after this one.
+//
+func (r *ListGetResponse) HasNext() bool {
+	return r.next != ""
+}
+
+// nextRequest creates the request that retrieves the next page of results. The link to the next
+// page returned by the server contains all the query parameters of the original request, including
+// filters and page size, so those are used instead of the parameters of the original request.
+//
+func (r *ListGetResponse) nextRequest() (request Request, err error) {
+	link, err := url.Parse(r.next)
+	if err != nil {
+		err = fmt.Errorf("Can't parse link to next page '%s': %s", r.next, err)
+		return
+	}
+	request.resource = r.resource
+	request.query = link.Query()
+	return
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the retrieval of lists of results split in pages.
+
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newPagedHostsServer creates a server that returns five hosts in pages of two, checking that the
+// filter and the page size are preserved when following the links to the next pages.
+func newPagedHostsServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("inventory") != "7" || query.Get("page_size") != "2" {
+			t.Errorf("Unexpected query '%s'", r.URL.RawQuery)
+		}
+		pages := map[string]string{
+			"":  `{"count": 5, "next": "/api/v2/hosts/?inventory=7&page=2&page_size=2", "results": [{"id": 1}, {"id": 2}]}`,
+			"2": `{"count": 5, "next": "/api/v2/hosts/?inventory=7&page=3&page_size=2", "results": [{"id": 3}, {"id": 4}]}`,
+			"3": `{"count": 5, "results": [{"id": 5}]}`,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, pages[query.Get("page")])
+	}))
+}
+
+func TestListAll(t *testing.T) {
+	server := newPagedHostsServer(t)
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	hosts, err := connection.Hosts().Get().
+		Filter("inventory", 7).
+		PageSize(2).
+		All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 5 {
+		t.Fatalf("Expected 5 hosts, got %d", len(hosts))
+	}
+	for i, host := range hosts {
+		if host.Id() != i+1 {
+			t.Errorf("Expected host %d to have identifier %d, got %d", i, i+1, host.Id())
+		}
+	}
+}
+
+func TestListEachStops(t *testing.T) {
+	server := newPagedHostsServer(t)
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	stop := fmt.Errorf("stop")
+	count := 0
+	err := connection.Hosts().Get().
+		Filter("inventory", 7).
+		PageSize(2).
+		Each(func(host *Host) error {
+			count++
+			if host.Id() == 3 {
+				return stop
+			}
+			return nil
+		})
+	if err != stop {
+		t.Errorf("Expected the error returned by the function, got %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 calls, got %d", count)
+	}
+}
+
+func TestListNextPage(t *testing.T) {
+	server := newPagedHostsServer(t)
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	response, err := connection.Hosts().Get().
+		Filter("inventory", 7).
+		PageSize(2).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pages := 1
+	for response.HasNext() {
+		response, err = response.NextPage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages++
+	}
+	if pages != 3 {
+		t.Errorf("Expected 3 pages, got %d", pages)
+	}
+	last, err := response.NextPage()
+	if last != nil || err != nil {
+		t.Errorf("Expected no page after the last one, got %v and %v", last, err)
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the credentials provider that obtains tokens from an
+// OAuth2 application.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// OAuth2Credentials returns a provider that obtains access and refresh tokens from the OAuth2
+// application with the given identifier and secret, using the password grant with the given user
+// name and password. When the access token is about to expire, or when the server rejects it, a
+// new one is requested using the refresh grant, falling back to the password grant if that fails.
+// The tokens are revoked when the connection is closed. The secret can be empty for public
+// applications. The provider can't be shared by multiple connections.
+//
+func OAuth2Credentials(clientId, clientSecret, username, password string) CredentialsProvider {
+	return &oauth2Credentials{
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		username:     username,
+		password:     password,
+	}
+}
+
+type oauth2Credentials struct {
+	clientId     string
+	clientSecret string
+	username     string
+	password     string
+	connection   *Connection
+
+	// Current tokens, and expiration time of the access token, zero if unknown:
+	access  string
+	refresh string
+	expires time.Time
+
+	// The token lock protects the tokens and the expiration time, and the auth lock makes sure
+	// that only one new token is requested at a time:
+	tokenLock sync.RWMutex
+	authLock  sync.Mutex
+}
+
+func (p *oauth2Credentials) bind(connection *Connection) {
+	p.connection = connection
+}
+
+// Authorization returns the current access token, requesting a new one if there is none or if it is
+// about to expire.
+//
+func (p *oauth2Credentials) Authorization(ctx context.Context) (string, error) {
+	authorization := p.current()
+	if authorization != "" {
+		return authorization, nil
+	}
+	p.authLock.Lock()
+	defer p.authLock.Unlock()
+	authorization = p.current()
+	if authorization != "" {
+		return authorization, nil
+	}
+	err := p.getToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// Don't check the expiration time of the new token, as it may be shorter than the margin:
+	p.tokenLock.RLock()
+	defer p.tokenLock.RUnlock()
+	return "Bearer " + p.access, nil
+}
+
+// current returns the value of the 'Authorization' header for the current access token, or an
+// empty string if there is no access token or it is about to expire.
+//
+func (p *oauth2Credentials) current() string {
+	p.tokenLock.RLock()
+	defer p.tokenLock.RUnlock()
+	if p.access == "" {
+		return ""
+	}
+	if !p.expires.IsZero() && !time.Now().Add(tokenExpiryMargin).Before(p.expires) {
+		return ""
+	}
+	return "Bearer " + p.access
+}
+
+// Invalidate marks the access token as expired, so that a new one will be requested using the
+// refresh token, but only if it is still the given one, as it may have been already replaced by
+// another goroutine. The token is kept so that it can be revoked if the refresh fails.
+//
+func (p *oauth2Credentials) Invalidate(authorization string) {
+	p.tokenLock.Lock()
+	defer p.tokenLock.Unlock()
+	if p.access != "" && authorization == "Bearer "+p.access {
+		p.expires = time.Now()
+	}
+}
+
+// Close revokes the tokens, so that they don't remain in the server. It is safe to call this method
+// multiple times, only the first call will revoke the tokens.
+//
+func (p *oauth2Credentials) Close() error {
+	p.authLock.Lock()
+	defer p.authLock.Unlock()
+	p.tokenLock.Lock()
+	access := p.access
+	refresh := p.refresh
+	p.access = ""
+	p.refresh = ""
+	p.expires = time.Time{}
+	p.tokenLock.Unlock()
+
+	// Revoking the refresh token also revokes the access tokens obtained with it, but the access
+	// token is revoked explicitly as well, in case the server didn't return a refresh token:
+	var result error
+	for _, token := range []string{refresh, access} {
+		if token == "" {
+			continue
+		}
+		err := p.revokeToken(context.Background(), token)
+		if err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+func (p *oauth2Credentials) revokeToken(ctx context.Context, token string) error {
+	if glog.V(2) {
+		glog.Infoln("Revoking OAuth2 token")
+	}
+	form := p.form()
+	form.Set("token", token)
+	err := p.connection.postForm(p.clientContext(ctx), "revoke_token", "o", form, nil)
+	if err != nil && !IsNotFound(err) && !IsUnauthorized(err) {
+		return fmt.Errorf("Can't revoke OAuth2 token: %w", err)
+	}
+	return nil
+}
+
+// getToken requests a new access token, using the refresh token if available, and the user name
+// and password otherwise.
+//
+func (p *oauth2Credentials) getToken(ctx context.Context) error {
+	p.tokenLock.RLock()
+	access := p.access
+	refresh := p.refresh
+	p.tokenLock.RUnlock()
+	if refresh != "" {
+		if glog.V(2) {
+			glog.Infoln("Requesting OAuth2 token using the refresh token")
+		}
+		form := p.form()
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", refresh)
+		err := p.requestToken(ctx, form)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		if glog.V(2) {
+			glog.Infof("Can't refresh OAuth2 token, will use the password: %s", err)
+		}
+	}
+	if glog.V(2) {
+		glog.Infoln("Requesting OAuth2 token using the password")
+	}
+	form := p.form()
+	form.Set("grant_type", "password")
+	form.Set("username", p.username)
+	form.Set("password", p.password)
+	form.Set("scope", "write")
+	err := p.requestToken(ctx, form)
+	if err != nil {
+		return err
+	}
+
+	// The password grant replaces the previous tokens instead of refreshing them, so revoke them,
+	// otherwise they would remain in the server till they expire:
+	for _, token := range []string{refresh, access} {
+		if token == "" {
+			continue
+		}
+		err = p.revokeToken(ctx, token)
+		if err != nil {
+			glog.Warning(err)
+		}
+	}
+	return nil
+}
+
+func (p *oauth2Credentials) requestToken(ctx context.Context, form url.Values) error {
+	var response data.OAuth2TokenPostResponse
+	err := p.connection.postForm(p.clientContext(ctx), "token", "o", form, &response)
+	if err != nil {
+		return err
+	}
+	if response.AccessToken == "" {
+		return fmt.Errorf("Error obtaining OAuth2 token")
+	}
+	p.tokenLock.Lock()
+	defer p.tokenLock.Unlock()
+	p.access = response.AccessToken
+
+	// The server may not return a new refresh token when refreshing, and then the current one is
+	// still valid, but it must not be kept when the password grant is used, as it will be revoked:
+	if response.RefreshToken != "" || form.Get("grant_type") != "refresh_token" {
+		p.refresh = response.RefreshToken
+	}
+	p.expires = time.Time{}
+	if response.ExpiresIn > 0 {
+		p.expires = time.Now().Add(time.Duration(response.ExpiresIn) * time.Second)
+	}
+	return nil
+}
+
+// form creates the form that identifies the application. Public applications don't have a secret,
+// so they are identified by the identifier sent in the form.
+//
+func (p *oauth2Credentials) form() url.Values {
+	form := url.Values{}
+	if p.clientSecret == "" {
+		form.Set("client_id", p.clientId)
+	}
+	return form
+}
+
+// clientContext returns a copy of the context containing the credentials of the application, if it
+// has a secret.
+//
+func (p *oauth2Credentials) clientContext(ctx context.Context) context.Context {
+	if p.clientSecret == "" {
+		return ctx
+	}
+	return withAuthorization(ctx, basicAuthorization(p.clientId, p.clientSecret))
+}
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the OAuth2 application credentials.
+
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// oauth2Server is a server that implements the OAuth2 token and revocation endpoints for an
+// application with identifier 'myclient' and secret 'mysecret'. The access tokens that it issues
+// expire immediately, so that the client has to refresh them for each request. When the refresh
+// grant is rejected the client has to use the password again.
+type oauth2Server struct {
+	*httptest.Server
+
+	lock          sync.Mutex
+	rejectRefresh bool
+	grants        []string
+	issued        int
+	access        string
+	refresh       string
+	revoked       []string
+}
+
+func newOAuth2Server(t *testing.T) *oauth2Server {
+	s := &oauth2Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/o/token/", "/api/o/revoke_token/":
+			id, secret, ok := r.BasicAuth()
+			if !ok || id != "myclient" || secret != "mysecret" {
+				t.Errorf("Unexpected client credentials '%s' and '%s'", id, secret)
+			}
+			err := r.ParseForm()
+			if err != nil {
+				t.Error(err)
+			}
+			if r.URL.Path == "/api/o/revoke_token/" {
+				s.revoked = append(s.revoked, r.PostForm.Get("token"))
+				return
+			}
+			grant := r.PostForm.Get("grant_type")
+			s.grants = append(s.grants, grant)
+			switch grant {
+			case "password":
+				if r.PostForm.Get("username") != "admin" || r.PostForm.Get("password") != "password" {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"error": "invalid_grant"}`)
+					return
+				}
+			case "refresh_token":
+				if s.rejectRefresh || r.PostForm.Get("refresh_token") != s.refresh {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"error": "invalid_grant"}`)
+					return
+				}
+			}
+			s.issued++
+			s.access = fmt.Sprintf("access%d", s.issued)
+			s.refresh = fmt.Sprintf("refresh%d", s.issued)
+			fmt.Fprintf(
+				w,
+				`{"access_token": "%s", "token_type": "Bearer", "expires_in": 1, "refresh_token": "%s"}`,
+				s.access, s.refresh,
+			)
+		default:
+			if r.Header.Get("Authorization") != "Bearer "+s.access {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"id": 1}`)
+		}
+	}))
+	return s
+}
+
+func TestOAuth2Client(t *testing.T) {
+	server := newOAuth2Server(t)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL+"/api").
+		Username("admin").
+		Password("password").
+		OAuth2Client("myclient", "mysecret").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = connection.Projects().Id(1).Get().Send()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = connection.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = connection.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedGrants := []string{"password", "refresh_token"}
+	if !reflect.DeepEqual(server.grants, expectedGrants) {
+		t.Errorf("Expected grants %v, got %v", expectedGrants, server.grants)
+	}
+	expectedRevoked := []string{"refresh2", "access2"}
+	if !reflect.DeepEqual(server.revoked, expectedRevoked) {
+		t.Errorf("Expected revoked tokens %v, got %v", expectedRevoked, server.revoked)
+	}
+}
+
+func TestOAuth2ClientRevokesReplacedTokens(t *testing.T) {
+	server := newOAuth2Server(t)
+	server.rejectRefresh = true
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL+"/api").
+		Username("admin").
+		Password("password").
+		OAuth2Client("myclient", "mysecret").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = connection.Projects().Id(1).Get().Send()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = connection.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedGrants := []string{"password", "refresh_token", "password"}
+	if !reflect.DeepEqual(server.grants, expectedGrants) {
+		t.Errorf("Expected grants %v, got %v", expectedGrants, server.grants)
+	}
+	expectedRevoked := []string{"refresh1", "access1", "refresh2", "access2"}
+	if !reflect.DeepEqual(server.revoked, expectedRevoked) {
+		t.Errorf("Expected revoked tokens %v, got %v", expectedRevoked, server.revoked)
+	}
+}
+
+func TestOAuth2ClientRequiresUsername(t *testing.T) {
+	_, err := NewConnectionBuilder().
+		URL("https://awx.example.com/api").
+		Bearer("mybearer").
+		OAuth2Client("myclient", "mysecret").
+		Build()
+	if err == nil {
+		t.Errorf("Expected an error when using an OAuth2 client without user name")
+	}
+}
@@ -18,14 +18,52 @@ limitations under the License.
 
 package awx
 
+import (
+	"time"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
 // Project represents an AWX project.
 //
 type Project struct {
-	id        int
-	name      string
-	scmType   string
-	scmURL    string
-	scmBranch string
+	id                    int
+	name                  string
+	description           string
+	organization          int
+	credential            int
+	scmType               string
+	scmURL                string
+	scmBranch             string
+	scmClean              bool
+	scmDeleteOnUpdate     bool
+	scmUpdateOnLaunch     bool
+	scmUpdateCacheTimeout int
+	localPath             string
+	status                JobStatus
+	lastUpdated           time.Time
+}
+
+// newProject creates a new project from the data returned by the server.
+//
+func newProject(output *data.Project) *Project {
+	project := new(Project)
+	project.id = output.Id
+	project.name = output.Name
+	project.description = output.Description
+	project.organization = output.Organization
+	project.credential = output.Credential
+	project.scmType = output.SCMType
+	project.scmURL = output.SCMURL
+	project.scmBranch = output.SCMBranch
+	project.scmClean = output.SCMClean
+	project.scmDeleteOnUpdate = output.SCMDeleteOnUpdate
+	project.scmUpdateOnLaunch = output.SCMUpdateOnLaunch
+	project.scmUpdateCacheTimeout = output.SCMUpdateCacheTimeout
+	project.localPath = output.LocalPath
+	project.status = (JobStatus)(output.Status)
+	project.lastUpdated = output.LastUpdated
+	return project
 }
 
 // Id returns the unique identifier of the project.
@@ -40,6 +78,25 @@ func (p *Project) Name() string {
 	return p.name
 }
 
+// Description returns the description of the project.
+//
+func (p *Project) Description() string {
+	return p.description
+}
+
+// Organization returns the identifier of the organization that the project belongs to.
+//
+func (p *Project) Organization() int {
+	return p.organization
+}
+
+// Credential returns the identifier of the credential used to access the source code management
+// system.
+//
+func (p *Project) Credential() int {
+	return p.credential
+}
+
 // SCMType returns the source code management system type of the project.
 //
 func (p *Project) SCMType() string {
@@ -57,3 +114,47 @@ func (p *Project) SCMURL() string {
 func (p *Project) SCMBranch() string {
 	return p.scmBranch
 }
+
+// SCMClean returns true if local modifications are discarded before updating the project.
+//
+func (p *Project) SCMClean() bool {
+	return p.scmClean
+}
+
+// SCMDeleteOnUpdate returns true if the local copy of the project is deleted before updating it.
+//
+func (p *Project) SCMDeleteOnUpdate() bool {
+	return p.scmDeleteOnUpdate
+}
+
+// SCMUpdateOnLaunch returns true if the project is updated each time a job using it is launched.
+//
+func (p *Project) SCMUpdateOnLaunch() bool {
+	return p.scmUpdateOnLaunch
+}
+
+// SCMUpdateCacheTimeout returns the number of seconds that the result of an update is considered
+// current when SCMUpdateOnLaunch is true.
+//
+func (p *Project) SCMUpdateCacheTimeout() int {
+	return p.scmUpdateCacheTimeout
+}
+
+// LocalPath returns the directory, relative to the projects base path of the server, that
+// contains the project.
+//
+func (p *Project) LocalPath() string {
+	return p.localPath
+}
+
+// Status returns the status of the last update of the project.
+//
+func (p *Project) Status() JobStatus {
+	return p.status
+}
+
+// LastUpdated returns the time of the last update of the project.
+//
+func (p *Project) LastUpdated() time.Time {
+	return p.lastUpdated
+}
@@ -19,6 +19,8 @@ limitations under the License.
 package awx
 
 import (
+	"context"
+
 	"github.com/moolitayer/awx-client-go/awx/internal/data"
 )
 
@@ -39,23 +41,54 @@ func (r *ProjectResource) Get() *ProjectGetRequest {
 	return request
 }
 
+func (r *ProjectResource) Patch() *ProjectPatchRequest {
+	request := new(ProjectPatchRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *ProjectResource) Delete() *ProjectDeleteRequest {
+	request := new(ProjectDeleteRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Update returns a request that starts an update of the project from its source code management
+// system. The update runs asynchronously, use the ProjectUpdates resource of the connection to
+// check its status.
+//
+func (r *ProjectResource) Update() *ProjectUpdatePostRequest {
+	request := new(ProjectUpdatePostRequest)
+	request.resource = &Resource{
+		connection: r.connection,
+		path:       r.path + "/update",
+	}
+	return request
+}
+
+// ProjectUpdates returns a reference to the resource that manages the collection of updates of
+// this project.
+//
+func (r *ProjectResource) ProjectUpdates() *ProjectUpdatesResource {
+	return NewProjectUpdatesResource(r.connection, r.path+"/project_updates")
+}
+
 type ProjectGetRequest struct {
 	Request
 }
 
 func (r *ProjectGetRequest) Send() (response *ProjectGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *ProjectGetRequest) SendContext(ctx context.Context) (response *ProjectGetResponse, err error) {
 	output := new(data.ProjectGetResponse)
-	err = r.get(output)
+	err = r.get(ctx, output)
 	if err != nil {
 		return
 	}
 	response = new(ProjectGetResponse)
-	response.result = new(Project)
-	response.result.id = output.Id
-	response.result.name = output.Name
-	response.result.scmType = output.SCMType
-	response.result.scmURL = output.SCMURL
-	response.result.scmBranch = output.SCMBranch
+	response.result = newProject(&output.Project)
 	return
 }
 
@@ -66,3 +99,147 @@ type ProjectGetResponse struct {
 func (r *ProjectGetResponse) Result() *Project {
 	return r.result
 }
+
+// ProjectPatchRequest modifies the project. Only the fields that are explicitly set are sent to
+// the server, the rest are left untouched.
+//
+type ProjectPatchRequest struct {
+	Request
+
+	input data.ProjectPatchRequest
+}
+
+func (r *ProjectPatchRequest) Name(value string) *ProjectPatchRequest {
+	r.input.Name = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) Description(value string) *ProjectPatchRequest {
+	r.input.Description = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) Organization(value int) *ProjectPatchRequest {
+	r.input.Organization = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) Credential(value int) *ProjectPatchRequest {
+	r.input.Credential = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) SCMType(value string) *ProjectPatchRequest {
+	r.input.SCMType = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) SCMURL(value string) *ProjectPatchRequest {
+	r.input.SCMURL = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) SCMBranch(value string) *ProjectPatchRequest {
+	r.input.SCMBranch = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) SCMClean(value bool) *ProjectPatchRequest {
+	r.input.SCMClean = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) SCMDeleteOnUpdate(value bool) *ProjectPatchRequest {
+	r.input.SCMDeleteOnUpdate = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) SCMUpdateOnLaunch(value bool) *ProjectPatchRequest {
+	r.input.SCMUpdateOnLaunch = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) SCMUpdateCacheTimeout(value int) *ProjectPatchRequest {
+	r.input.SCMUpdateCacheTimeout = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) LocalPath(value string) *ProjectPatchRequest {
+	r.input.LocalPath = &value
+	return r
+}
+
+func (r *ProjectPatchRequest) Send() (response *ProjectPatchResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *ProjectPatchRequest) SendContext(ctx context.Context) (response *ProjectPatchResponse, err error) {
+	output := new(data.ProjectPatchResponse)
+	err = r.patch(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(ProjectPatchResponse)
+	response.result = newProject(&output.Project)
+	return
+}
+
+type ProjectPatchResponse struct {
+	result *Project
+}
+
+func (r *ProjectPatchResponse) Result() *Project {
+	return r.result
+}
+
+type ProjectDeleteRequest struct {
+	Request
+}
+
+func (r *ProjectDeleteRequest) Send() (response *ProjectDeleteResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *ProjectDeleteRequest) SendContext(ctx context.Context) (response *ProjectDeleteResponse, err error) {
+	err = r.delete(ctx)
+	if err != nil {
+		return
+	}
+	response = new(ProjectDeleteResponse)
+	return
+}
+
+type ProjectDeleteResponse struct {
+}
+
+type ProjectUpdatePostRequest struct {
+	Request
+}
+
+func (r *ProjectUpdatePostRequest) Send() (response *ProjectUpdatePostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *ProjectUpdatePostRequest) SendContext(ctx context.Context) (response *ProjectUpdatePostResponse, err error) {
+	output := new(data.ProjectUpdatePostResponse)
+	err = r.post(ctx, struct{}{}, output)
+	if err != nil {
+		return
+	}
+	response = new(ProjectUpdatePostResponse)
+	response.result = newProjectUpdate(&output.ProjectUpdate)
+	if output.ProjectUpdateId != 0 {
+		response.result.id = output.ProjectUpdateId
+	}
+	return
+}
+
+type ProjectUpdatePostResponse struct {
+	result *ProjectUpdate
+}
+
+// ProjectUpdate returns the project update that has been started.
+//
+func (r *ProjectUpdatePostResponse) ProjectUpdate() *ProjectUpdate {
+	return r.result
+}
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the projects resources.
+
+package awx
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProjectsPost(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/projects/", http.StatusCreated, `{
+		"id": 4,
+		"name": "myproject",
+		"organization": 1,
+		"scm_type": "git",
+		"status": "pending"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Projects().Post().
+		Name("myproject").
+		Organization(1).
+		SCMType("git").
+		SCMURL("https://github.com/ansible/ansible-tower-samples").
+		SCMBranch("master").
+		SCMClean(false).
+		SCMUpdateOnLaunch(true).
+		SCMUpdateCacheTimeout(60).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/projects/").body
+	if body["name"] != "myproject" || body["organization"] != 1.0 || body["scm_type"] != "git" {
+		t.Errorf("Expected the name, organization and SCM type, got %v", body)
+	}
+	if body["scm_url"] != "https://github.com/ansible/ansible-tower-samples" || body["scm_branch"] != "master" {
+		t.Errorf("Expected the SCM URL and branch, got %v", body)
+	}
+
+	// Flags explicitly set to false must be sent, as the server may have a different default:
+	if body["scm_clean"] != false || body["scm_update_on_launch"] != true {
+		t.Errorf("Expected the SCM clean and update on launch flags, got %v", body)
+	}
+	if body["scm_update_cache_timeout"] != 60.0 {
+		t.Errorf("Expected the SCM update cache timeout, got %v", body)
+	}
+	for _, field := range []string{"credential", "scm_delete_on_update", "local_path"} {
+		if _, ok := body[field]; ok {
+			t.Errorf("Expected no '%s' field, got %v", field, body)
+		}
+	}
+	project := response.Result()
+	if project.Id() != 4 || project.Status() != JobStatusPending {
+		t.Errorf("Unexpected project %+v", project)
+	}
+}
+
+func TestProjectGetAllFields(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/projects/4/", http.StatusOK, `{
+		"id": 4,
+		"name": "myproject",
+		"organization": 1,
+		"credential": 2,
+		"scm_type": "git",
+		"scm_url": "https://github.com/ansible/ansible-tower-samples",
+		"scm_branch": "master",
+		"scm_clean": true,
+		"scm_delete_on_update": true,
+		"scm_update_on_launch": true,
+		"scm_update_cache_timeout": 60,
+		"local_path": "_4__myproject",
+		"status": "successful",
+		"last_updated": "2018-05-09T10:04:35Z"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Projects().Id(4).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	project := response.Result()
+	if project.Organization() != 1 || project.Credential() != 2 || project.LocalPath() != "_4__myproject" {
+		t.Errorf("Unexpected project %+v", project)
+	}
+	if !project.SCMClean() || !project.SCMDeleteOnUpdate() || !project.SCMUpdateOnLaunch() {
+		t.Errorf("Unexpected project %+v", project)
+	}
+	if project.SCMUpdateCacheTimeout() != 60 || project.Status() != JobStatusSuccesful {
+		t.Errorf("Unexpected project %+v", project)
+	}
+	expected := time.Date(2018, 5, 9, 10, 4, 35, 0, time.UTC)
+	if !project.LastUpdated().Equal(expected) {
+		t.Errorf("Expected last updated %s, got %s", expected, project.LastUpdated())
+	}
+}
+
+func TestProjectPatchSendsOnlyChanges(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPatch, "/api/v2/projects/4/", http.StatusOK, `{
+		"id": 4,
+		"scm_branch": "devel"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Projects().Id(4).Patch().
+		SCMBranch("devel").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPatch, "/api/v2/projects/4/").body
+	expected := map[string]interface{}{
+		"scm_branch": "devel",
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+	if response.Result().SCMBranch() != "devel" {
+		t.Errorf("Expected branch 'devel', got '%s'", response.Result().SCMBranch())
+	}
+}
+
+func TestProjectDelete(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodDelete, "/api/v2/projects/4/", http.StatusNoContent, "")
+	connection := server.connect(t)
+
+	_, err := connection.Projects().Id(4).Delete().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.count(http.MethodDelete, "/api/v2/projects/4/") != 1 {
+		t.Errorf("Expected the project to be deleted, got %v", server.recorded())
+	}
+}
+
+func TestProjectsPostRejected(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(
+		http.MethodPost, "/api/v2/projects/", http.StatusBadRequest,
+		`{"name": ["This field may not be blank."]}`,
+	)
+	connection := server.connect(t)
+
+	response, err := connection.Projects().Post().Name("").Send()
+	if err == nil {
+		t.Errorf("Expected an error, got project %+v", response.Result())
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the project update type.
+
+package awx
+
+import (
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// ProjectUpdate represents the job that updates a project from its source code management
+// system.
+//
+type ProjectUpdate struct {
+	id             int
+	name           string
+	status         JobStatus
+	failed         bool
+	project        int
+	jobExplanation string
+}
+
+// newProjectUpdate creates a new project update from the data returned by the server.
+//
+func newProjectUpdate(output *data.ProjectUpdate) *ProjectUpdate {
+	update := new(ProjectUpdate)
+	update.id = output.Id
+	update.name = output.Name
+	update.status = (JobStatus)(output.Status)
+	update.failed = output.Failed
+	update.project = output.Project
+	update.jobExplanation = output.JobExplanation
+	return update
+}
+
+func (u *ProjectUpdate) Id() int {
+	return u.id
+}
+
+func (u *ProjectUpdate) Name() string {
+	return u.name
+}
+
+func (u *ProjectUpdate) Status() JobStatus {
+	return u.status
+}
+
+func (u *ProjectUpdate) Failed() bool {
+	return u.failed
+}
+
+// Project returns the identifier of the project that is being updated.
+//
+func (u *ProjectUpdate) Project() int {
+	return u.project
+}
+
+// JobExplanation returns the explanation given by the server when the update couldn't run.
+//
+func (u *ProjectUpdate) JobExplanation() string {
+	return u.jobExplanation
+}
+
+func (u *ProjectUpdate) IsFinished() bool {
+	return u.status.IsFinished()
+}
+
+func (u *ProjectUpdate) IsSuccessful() bool {
+	return u.status == JobStatusSuccesful
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a specific project update.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type ProjectUpdateResource struct {
+	Resource
+}
+
+func NewProjectUpdateResource(connection *Connection, path string) *ProjectUpdateResource {
+	resource := new(ProjectUpdateResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *ProjectUpdateResource) Get() *ProjectUpdateGetRequest {
+	request := new(ProjectUpdateGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Stdout returns a request that retrieves the output of the update.
+//
+func (r *ProjectUpdateResource) Stdout() *StdoutGetRequest {
+	return newStdoutGetRequest(r.connection, r.path+"/stdout")
+}
+
+type ProjectUpdateGetRequest struct {
+	Request
+}
+
+func (r *ProjectUpdateGetRequest) Send() (response *ProjectUpdateGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *ProjectUpdateGetRequest) SendContext(ctx context.Context) (response *ProjectUpdateGetResponse, err error) {
+	output := new(data.ProjectUpdateGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(ProjectUpdateGetResponse)
+	response.result = newProjectUpdate(&output.ProjectUpdate)
+	return
+}
+
+type ProjectUpdateGetResponse struct {
+	result *ProjectUpdate
+}
+
+func (r *ProjectUpdateGetResponse) Result() *ProjectUpdate {
+	return r.result
+}
@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the project updates.
+
+package awx
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestProjectUpdate(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodPost, "/api/v2/projects/4/update/", http.StatusAccepted, `{
+		"project_update": 15,
+		"status": "pending"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Projects().Id(4).Update().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := server.last(t, http.MethodPost, "/api/v2/projects/4/update/").body
+	if len(body) != 0 {
+		t.Errorf("Expected an empty body, got %v", body)
+	}
+	update := response.ProjectUpdate()
+	if update.Id() != 15 || update.Status() != JobStatusPending || update.IsFinished() {
+		t.Errorf("Unexpected project update %+v", update)
+	}
+}
+
+func TestProjectUpdatesGetFilter(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/projects/4/project_updates/", http.StatusOK, `{
+		"count": 1,
+		"results": [{
+			"id": 15,
+			"status": "failed",
+			"failed": true,
+			"project": 4,
+			"job_explanation": "Previous Task Failed"
+		}]
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Projects().Id(4).ProjectUpdates().Get().
+		Filter("status", "failed").
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := server.last(t, http.MethodGet, "/api/v2/projects/4/project_updates/").query
+	if query.Get("status") != "failed" {
+		t.Errorf("Expected the status filter, got '%s'", query.Encode())
+	}
+	updates := response.Results()
+	if len(updates) != 1 {
+		t.Fatalf("Expected 1 project update, got %d", len(updates))
+	}
+	update := updates[0]
+	if !update.IsFinished() || update.IsSuccessful() || !update.Failed() || update.Project() != 4 {
+		t.Errorf("Unexpected project update %+v", update)
+	}
+	if update.JobExplanation() != "Previous Task Failed" {
+		t.Errorf("Unexpected explanation '%s'", update.JobExplanation())
+	}
+}
+
+func TestProjectUpdateGet(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/project_updates/15/", http.StatusOK, `{
+		"id": 15,
+		"status": "successful",
+		"project": 4
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.Projects().Id(4).ProjectUpdates().Id(15).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := response.Result()
+	if update.Id() != 15 || !update.IsSuccessful() || update.Failed() {
+		t.Errorf("Unexpected project update %+v", update)
+	}
+}
+
+func TestProjectUpdateStdout(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(http.MethodGet, "/api/v2/project_updates/15/stdout/", http.StatusOK, `{
+		"range": {"start": 0, "end": 1, "absolute_end": 1},
+		"content": "Updating project\n"
+	}`)
+	connection := server.connect(t)
+
+	response, err := connection.ProjectUpdates().Id(15).Stdout().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := server.last(t, http.MethodGet, "/api/v2/project_updates/15/stdout/").query
+	if query.Get("format") != "json" {
+		t.Errorf("Expected the JSON format, got '%s'", query.Encode())
+	}
+	if response.Content() != "Updating project\n" || response.AbsoluteEnd() != 1 {
+		t.Errorf("Unexpected output '%s'", response.Content())
+	}
+}
+
+func TestProjectUpdateNotAllowed(t *testing.T) {
+	server := newRecordingServer(t)
+	defer server.Close()
+	server.respond(
+		http.MethodPost, "/api/v2/projects/4/update/", http.StatusForbidden,
+		`{"detail": "You do not have permission to perform this action."}`,
+	)
+	connection := server.connect(t)
+
+	response, err := connection.Projects().Id(4).Update().Send()
+	if err == nil {
+		t.Errorf("Expected an error, got project update %+v", response.ProjectUpdate())
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages a collection of project
+// updates.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type ProjectUpdatesResource struct {
+	Resource
+}
+
+func NewProjectUpdatesResource(connection *Connection, path string) *ProjectUpdatesResource {
+	resource := new(ProjectUpdatesResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *ProjectUpdatesResource) Get() *ProjectUpdatesGetRequest {
+	request := new(ProjectUpdatesGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+// Id returns the resource that manages the project update with the given identifier. Note that
+// project updates are always managed using the top level 'project_updates' collection, even when
+// this resource is the collection of updates of a project.
+//
+func (r *ProjectUpdatesResource) Id(id int) *ProjectUpdateResource {
+	return NewProjectUpdateResource(r.connection, fmt.Sprintf("project_updates/%d", id))
+}
+
+type ProjectUpdatesGetRequest struct {
+	Request
+}
+
+func (r *ProjectUpdatesGetRequest) Filter(name string, value interface{}) *ProjectUpdatesGetRequest {
+	r.addFilter(name, value)
+	return r
+}
+
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *ProjectUpdatesGetRequest) PageSize(value int) *ProjectUpdatesGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the project updates.
+// It stops and returns the error if the function returns an error.
+//
+func (r *ProjectUpdatesGetRequest) Each(f func(projectUpdate *ProjectUpdate) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *ProjectUpdatesGetRequest) EachContext(ctx context.Context, f func(projectUpdate *ProjectUpdate) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, projectUpdate := range response.results {
+			err = f(projectUpdate)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the project updates.
+//
+func (r *ProjectUpdatesGetRequest) All() (results []*ProjectUpdate, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *ProjectUpdatesGetRequest) AllContext(ctx context.Context) (results []*ProjectUpdate, err error) {
+	err = r.EachContext(ctx, func(projectUpdate *ProjectUpdate) error {
+		results = append(results, projectUpdate)
+		return nil
+	})
+	return
+}
+
+func (r *ProjectUpdatesGetRequest) Send() (response *ProjectUpdatesGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *ProjectUpdatesGetRequest) SendContext(ctx context.Context) (response *ProjectUpdatesGetResponse, err error) {
+	output := new(data.ProjectUpdatesGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(ProjectUpdatesGetResponse)
+	response.count = output.Count
+	response.previous = output.Previous
+	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
+	response.results = make([]*ProjectUpdate, len(output.Results))
+	for i := 0; i < len(output.Results); i++ {
+		response.results[i] = newProjectUpdate(output.Results[i])
+	}
+	return
+}
+
+type ProjectUpdatesGetResponse struct {
+	ListGetResponse
+
+	results []*ProjectUpdate
+}
+
+func (r *ProjectUpdatesGetResponse) Results() []*ProjectUpdate {
+	return r.results
+}
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *ProjectUpdatesGetResponse) NextPage() (response *ProjectUpdatesGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(ProjectUpdatesGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
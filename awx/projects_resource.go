@@ -20,6 +20,7 @@ limitations under the License.
 package awx
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/moolitayer/awx-client-go/awx/internal/data"
@@ -42,6 +43,12 @@ func (r *ProjectsResource) Get() *ProjectsGetRequest {
 	return request
 }
 
+func (r *ProjectsResource) Post() *ProjectsPostRequest {
+	request := new(ProjectsPostRequest)
+	request.resource = &r.Resource
+	return request
+}
+
 func (r *ProjectsResource) Id(id int) *ProjectResource {
 	return NewProjectResource(r.connection, fmt.Sprintf("%s/%d", r.path, id))
 }
@@ -55,9 +62,60 @@ func (r *ProjectsGetRequest) Filter(name string, value interface{}) *ProjectsGet
 	return r
 }
 
+// PageSize sets the maximum number of results returned in each page.
+//
+func (r *ProjectsGetRequest) PageSize(value int) *ProjectsGetRequest {
+	r.setParameter("page_size", value)
+	return r
+}
+
+// Each retrieves all the pages of results and calls the given function for each of the projects.
+// It stops and returns the error if the function returns an error.
+//
+func (r *ProjectsGetRequest) Each(f func(project *Project) error) error {
+	return r.EachContext(context.Background(), f)
+}
+
+// EachContext is like Each, but the requests for the pages are sent using the given context, so
+// that the iteration can be cancelled or given a deadline.
+//
+func (r *ProjectsGetRequest) EachContext(ctx context.Context, f func(project *Project) error) error {
+	response, err := r.SendContext(ctx)
+	for err == nil && response != nil {
+		for _, project := range response.results {
+			err = f(project)
+			if err != nil {
+				return err
+			}
+		}
+		response, err = response.NextPage()
+	}
+	return err
+}
+
+// All retrieves all the pages of results and returns all the projects.
+//
+func (r *ProjectsGetRequest) All() (results []*Project, err error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All, but the requests for the pages are sent using the given context.
+//
+func (r *ProjectsGetRequest) AllContext(ctx context.Context) (results []*Project, err error) {
+	err = r.EachContext(ctx, func(project *Project) error {
+		results = append(results, project)
+		return nil
+	})
+	return
+}
+
 func (r *ProjectsGetRequest) Send() (response *ProjectsGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *ProjectsGetRequest) SendContext(ctx context.Context) (response *ProjectsGetResponse, err error) {
 	output := new(data.ProjectsGetResponse)
-	err = r.get(output)
+	err = r.get(ctx, output)
 	if err != nil {
 		return
 	}
@@ -65,14 +123,11 @@ func (r *ProjectsGetRequest) Send() (response *ProjectsGetResponse, err error) {
 	response.count = output.Count
 	response.previous = output.Previous
 	response.next = output.Next
+	response.resource = r.resource
+	response.ctx = ctx
 	response.results = make([]*Project, len(output.Results))
 	for i := 0; i < len(output.Results); i++ {
-		response.results[i] = new(Project)
-		response.results[i].id = output.Results[i].Id
-		response.results[i].name = output.Results[i].Name
-		response.results[i].scmType = output.Results[i].SCMType
-		response.results[i].scmURL = output.Results[i].SCMURL
-		response.results[i].scmBranch = output.Results[i].SCMBranch
+		response.results[i] = newProject(output.Results[i])
 	}
 	return
 }
@@ -86,3 +141,138 @@ type ProjectsGetResponse struct {
 func (r *ProjectsGetResponse) Results() []*Project {
 	return r.results
 }
+
+// NextPage retrieves the next page of results, using the same context that was used to retrieve
+// this page. It returns nil if this is the last page.
+//
+func (r *ProjectsGetResponse) NextPage() (response *ProjectsGetResponse, err error) {
+	if r.next == "" {
+		return
+	}
+	request := new(ProjectsGetRequest)
+	request.Request, err = r.nextRequest()
+	if err != nil {
+		return
+	}
+	return request.SendContext(r.ctx)
+}
+
+type ProjectsPostRequest struct {
+	Request
+
+	input data.ProjectPostRequest
+}
+
+// Name sets the name of the new project. It is mandatory.
+//
+func (r *ProjectsPostRequest) Name(value string) *ProjectsPostRequest {
+	r.input.Name = &value
+	return r
+}
+
+// Description sets the description of the new project.
+//
+func (r *ProjectsPostRequest) Description(value string) *ProjectsPostRequest {
+	r.input.Description = &value
+	return r
+}
+
+// Organization sets the identifier of the organization that the new project will belong to.
+//
+func (r *ProjectsPostRequest) Organization(value int) *ProjectsPostRequest {
+	r.input.Organization = &value
+	return r
+}
+
+// Credential sets the identifier of the credential used to access the source code management
+// system.
+//
+func (r *ProjectsPostRequest) Credential(value int) *ProjectsPostRequest {
+	r.input.Credential = &value
+	return r
+}
+
+// SCMType sets the source code management system type, for example 'git'. An empty string means
+// that the project is a directory of the server, see LocalPath.
+//
+func (r *ProjectsPostRequest) SCMType(value string) *ProjectsPostRequest {
+	r.input.SCMType = &value
+	return r
+}
+
+// SCMURL sets the URL of the source code management system repository.
+//
+func (r *ProjectsPostRequest) SCMURL(value string) *ProjectsPostRequest {
+	r.input.SCMURL = &value
+	return r
+}
+
+// SCMBranch sets the branch, tag or commit to checkout.
+//
+func (r *ProjectsPostRequest) SCMBranch(value string) *ProjectsPostRequest {
+	r.input.SCMBranch = &value
+	return r
+}
+
+// SCMClean sets the flag that indicates if local modifications should be discarded before
+// updating the project.
+//
+func (r *ProjectsPostRequest) SCMClean(value bool) *ProjectsPostRequest {
+	r.input.SCMClean = &value
+	return r
+}
+
+// SCMDeleteOnUpdate sets the flag that indicates if the local copy of the project should be
+// deleted before updating it.
+//
+func (r *ProjectsPostRequest) SCMDeleteOnUpdate(value bool) *ProjectsPostRequest {
+	r.input.SCMDeleteOnUpdate = &value
+	return r
+}
+
+// SCMUpdateOnLaunch sets the flag that indicates if the project should be updated each time a job
+// using it is launched.
+//
+func (r *ProjectsPostRequest) SCMUpdateOnLaunch(value bool) *ProjectsPostRequest {
+	r.input.SCMUpdateOnLaunch = &value
+	return r
+}
+
+// SCMUpdateCacheTimeout sets the number of seconds that the result of an update is considered
+// current when SCMUpdateOnLaunch is true.
+//
+func (r *ProjectsPostRequest) SCMUpdateCacheTimeout(value int) *ProjectsPostRequest {
+	r.input.SCMUpdateCacheTimeout = &value
+	return r
+}
+
+// LocalPath sets the directory, relative to the projects base path of the server, that contains
+// the project. It is only used when the SCM type is empty.
+//
+func (r *ProjectsPostRequest) LocalPath(value string) *ProjectsPostRequest {
+	r.input.LocalPath = &value
+	return r
+}
+
+func (r *ProjectsPostRequest) Send() (response *ProjectsPostResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *ProjectsPostRequest) SendContext(ctx context.Context) (response *ProjectsPostResponse, err error) {
+	output := new(data.ProjectPostResponse)
+	err = r.post(ctx, &r.input, output)
+	if err != nil {
+		return
+	}
+	response = new(ProjectsPostResponse)
+	response.result = newProject(&output.Project)
+	return
+}
+
+type ProjectsPostResponse struct {
+	result *Project
+}
+
+func (r *ProjectsPostResponse) Result() *Project {
+	return r.result
+}
@@ -20,6 +20,7 @@ limitations under the License.
 package awx
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -33,13 +34,38 @@ func (r *Request) addFilter(name string, value interface{}) {
 	if r.query == nil {
 		r.query = make(url.Values)
 	}
-	r.query.Add(name, fmt.Sprintf("%s", value))
+	r.query.Add(name, fmt.Sprintf("%v", value))
 }
 
-func (r *Request) get(output interface{}) error {
-	return r.resource.get(r.query, output)
+// setParameter sets the value of a query parameter, replacing any previous value.
+//
+func (r *Request) setParameter(name string, value interface{}) {
+	if r.query == nil {
+		r.query = make(url.Values)
+	}
+	r.query.Set(name, fmt.Sprintf("%v", value))
+}
+
+func (r *Request) get(ctx context.Context, output interface{}) error {
+	return r.resource.get(ctx, r.query, output)
+}
+
+func (r *Request) getRaw(ctx context.Context, accept string) ([]byte, error) {
+	return r.resource.getRaw(ctx, r.query, accept)
+}
+
+func (r *Request) post(ctx context.Context, input interface{}, output interface{}) error {
+	return r.resource.post(ctx, r.query, input, output)
+}
+
+func (r *Request) put(ctx context.Context, input interface{}, output interface{}) error {
+	return r.resource.put(ctx, r.query, input, output)
+}
+
+func (r *Request) patch(ctx context.Context, input interface{}, output interface{}) error {
+	return r.resource.patch(ctx, r.query, input, output)
 }
 
-func (r *Request) post(input interface{}, output interface{}) error {
-	return r.resource.post(r.query, input, output)
+func (r *Request) delete(ctx context.Context) error {
+	return r.resource.delete(ctx, r.query)
 }
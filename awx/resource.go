@@ -19,6 +19,7 @@ limitations under the License.
 package awx
 
 import (
+	"context"
 	"net/url"
 )
 
@@ -27,12 +28,28 @@ type Resource struct {
 	path       string
 }
 
-func (r *Resource) get(query url.Values, output interface{}) error {
-	return r.connection.authenticatedGet(r.path, query, output)
+func (r *Resource) get(ctx context.Context, query url.Values, output interface{}) error {
+	return r.connection.authenticatedGet(ctx, r.path, query, output)
 }
 
-func (r *Resource) post(query url.Values, input interface{}, output interface{}) error {
-	return r.connection.authenticatedPost(r.path, query, input, output)
+func (r *Resource) getRaw(ctx context.Context, query url.Values, accept string) ([]byte, error) {
+	return r.connection.authenticatedRawGet(ctx, r.path, query, accept)
+}
+
+func (r *Resource) post(ctx context.Context, query url.Values, input interface{}, output interface{}) error {
+	return r.connection.authenticatedPost(ctx, r.path, query, input, output)
+}
+
+func (r *Resource) put(ctx context.Context, query url.Values, input interface{}, output interface{}) error {
+	return r.connection.authenticatedPut(ctx, r.path, query, input, output)
+}
+
+func (r *Resource) patch(ctx context.Context, query url.Values, input interface{}, output interface{}) error {
+	return r.connection.authenticatedPatch(ctx, r.path, query, input, output)
+}
+
+func (r *Resource) delete(ctx context.Context, query url.Values) error {
+	return r.connection.authenticatedDelete(ctx, r.path, query)
 }
 
 func (r *Resource) String() string {
@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the policy that decides when and how failed requests
+// are retried.
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the connection retries requests that fail because of transient
+// problems, like network timeouts, connections refused or reset by the server, or load balancers
+// that temporarily can't reach the server. Errors that won't go away by repeating the request,
+// like TLS certificate errors or unknown host names, aren't retried. The zero value of each field
+// means that the default described for that field is used.
+//
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times that a request is sent, including the first one.
+	// The default is three. Use one to disable retries.
+	MaxAttempts int
+
+	// Interval is the time to wait before the first retry. The default is one second.
+	Interval time.Duration
+
+	// MaxInterval is the maximum time to wait between retries. The default is thirty seconds.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor applied to the interval after each retry. The default is two.
+	Multiplier float64
+
+	// Jitter is the fraction of the interval that is randomly added or subtracted, so that
+	// multiple clients don't retry at the same time. The default is 0.2. Use a negative value
+	// to disable it.
+	Jitter float64
+
+	// StatusCodes are the response status codes that cause a retry. The default is 429, 502,
+	// 503 and 504. When the response contains a 'Retry-After' header, the time indicated by the
+	// server is used instead of the interval, but never more than MaxInterval.
+	StatusCodes []int
+
+	// Methods are the HTTP methods of the requests that can be retried. The default is only GET
+	// and HEAD, as retrying other methods may repeat operations that the server has already
+	// performed, like launching a job. Add POST explicitly to retry those requests too.
+	Methods []string
+}
+
+// newRetryPolicy creates a copy of the given policy with the defaults applied. The given policy can
+// be nil, in which case all the defaults are used.
+//
+func newRetryPolicy(policy *RetryPolicy) *RetryPolicy {
+	result := &RetryPolicy{
+		MaxAttempts: 3,
+		Interval:    time.Second,
+		MaxInterval: 30 * time.Second,
+		Multiplier:  2,
+		Jitter:      0.2,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		Methods: []string{
+			http.MethodGet,
+			http.MethodHead,
+		},
+	}
+	if policy == nil {
+		return result
+	}
+	if policy.MaxAttempts > 0 {
+		result.MaxAttempts = policy.MaxAttempts
+	}
+	if policy.Interval > 0 {
+		result.Interval = policy.Interval
+	}
+	if policy.MaxInterval > 0 {
+		result.MaxInterval = policy.MaxInterval
+	}
+	if policy.Multiplier >= 1 {
+		result.Multiplier = policy.Multiplier
+	}
+	if policy.Jitter < 0 {
+		result.Jitter = 0
+	} else if policy.Jitter > 0 {
+		result.Jitter = policy.Jitter
+	}
+	if len(policy.StatusCodes) > 0 {
+		result.StatusCodes = append([]int(nil), policy.StatusCodes...)
+	}
+	if len(policy.Methods) > 0 {
+		result.Methods = append([]string(nil), policy.Methods...)
+	}
+	return result
+}
+
+// delay checks if the request with the given method should be retried after the given attempt,
+// that produced the given response or error. If it should it returns the time to wait before the
+// next attempt and true.
+//
+func (p *RetryPolicy) delay(method string, attempt int, response *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || !p.retriesMethod(method) {
+		return 0, false
+	}
+	if err != nil && !retriesError(err) {
+		return 0, false
+	}
+	if err == nil && !p.retriesStatus(response.StatusCode) {
+		return 0, false
+	}
+
+	// Calculate the backoff for this attempt:
+	interval := float64(p.Interval)
+	for i := 1; i < attempt; i++ {
+		interval *= p.Multiplier
+	}
+	if interval > float64(p.MaxInterval) {
+		interval = float64(p.MaxInterval)
+	}
+	if p.Jitter > 0 {
+		interval += interval * p.Jitter * (2*rand.Float64() - 1)
+	}
+	result := time.Duration(interval)
+
+	// The time requested by the server takes precedence, but is still limited by the maximum
+	// interval, so that a misbehaving server can't block the caller for a long time:
+	if response != nil {
+		after, ok := parseRetryAfter(response.Header.Get("Retry-After"))
+		if ok {
+			result = after
+			if result > p.MaxInterval {
+				result = p.MaxInterval
+			}
+		}
+	}
+
+	return result, true
+}
+
+func (p *RetryPolicy) retriesMethod(method string) bool {
+	for _, candidate := range p.Methods {
+		if candidate == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) retriesStatus(code int) bool {
+	for _, candidate := range p.StatusCodes {
+		if candidate == code {
+			return true
+		}
+	}
+	return false
+}
+
+// retriesError checks if the given error, returned while sending a request or reading its
+// response, is a transient network problem that may go away if the request is sent again.
+//
+func retriesError(err error) bool {
+	// Cancellation and deadlines of the context are explicit decisions of the caller:
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	// Name resolution errors are only retried when the resolver says that they are transient:
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	// Connections refused, reset or closed before completing the response:
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	// Timeouts, including the ones of the TLS handshake:
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout()
+	}
+
+	return false
+}
+
+// parseRetryAfter parses the value of the 'Retry-After' header, which can be a number of seconds or
+// a date.
+//
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the retrying of failed requests.
+
+package awx
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// newFlakyServer creates a server that responds to the first requests with the given status code,
+// and then with an empty project. The number of requests received is stored in the given counter.
+func newFlakyServer(failures int, code int, count *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*count++
+		w.Header().Set("Content-Type", "application/json")
+		if *count <= failures {
+			if code == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+			w.WriteHeader(code)
+			fmt.Fprint(w, `{"detail": "Try later."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+}
+
+func TestRetryGet(t *testing.T) {
+	count := 0
+	server := newFlakyServer(2, http.StatusBadGateway, &count)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Bearer("token").
+		Retry(&RetryPolicy{
+			Interval: time.Millisecond,
+		}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	response, err := connection.Projects().Id(1).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Result().Id() != 1 {
+		t.Errorf("Expected project 1, got %d", response.Result().Id())
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 attempts, got %d", count)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	count := 0
+	server := newFlakyServer(5, http.StatusServiceUnavailable, &count)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Bearer("token").
+		Retry(&RetryPolicy{
+			MaxAttempts: 2,
+			Interval:    time.Millisecond,
+		}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	_, err = connection.Projects().Id(1).Get().Send()
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.StatusCode() != http.StatusServiceUnavailable {
+		t.Errorf("Expected service unavailable error, got %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 attempts, got %d", count)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	count := 0
+	server := newFlakyServer(1, http.StatusTooManyRequests, &count)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Bearer("token").
+		Retry(&RetryPolicy{
+			// The server asks to retry immediately, so this should be ignored:
+			Interval: time.Hour,
+		}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	_, err = connection.Projects().Id(1).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 attempts, got %d", count)
+	}
+}
+
+func TestRetryPostOptIn(t *testing.T) {
+	for _, methods := range [][]string{nil, {http.MethodPost}} {
+		count := 0
+		server := newFlakyServer(1, http.StatusBadGateway, &count)
+		connection, err := NewConnectionBuilder().
+			URL(server.URL + "/api").
+			Bearer("token").
+			Retry(&RetryPolicy{
+				Interval: time.Millisecond,
+				Methods:  methods,
+			}).
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = connection.Projects().Post().Name("my-project").Send()
+		if methods == nil {
+			if err == nil || count != 1 {
+				t.Errorf("Expected POST not to be retried by default, got %d attempts and error %v", count, err)
+			}
+		} else {
+			if err != nil || count != 2 {
+				t.Errorf("Expected POST to be retried, got %d attempts and error %v", count, err)
+			}
+		}
+		connection.Close()
+		server.Close()
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	after, ok := parseRetryAfter("120")
+	if !ok || after != 2*time.Minute {
+		t.Errorf("Expected two minutes, got %s", after)
+	}
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	after, ok = parseRetryAfter(date)
+	if !ok || after <= 59*time.Minute || after > time.Hour {
+		t.Errorf("Expected about one hour, got %s", after)
+	}
+	_, ok = parseRetryAfter("junk")
+	if ok {
+		t.Errorf("Expected junk to be rejected")
+	}
+}
+
+func TestRetryErrors(t *testing.T) {
+	wrap := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://awx.example.com/api/", Err: err}
+	}
+	errors := map[string]struct {
+		err   error
+		retry bool
+	}{
+		"connection refused": {
+			err:   wrap(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}),
+			retry: true,
+		},
+		"connection reset": {
+			err:   wrap(&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}),
+			retry: true,
+		},
+		"unexpected end of file": {
+			err:   wrap(io.ErrUnexpectedEOF),
+			retry: true,
+		},
+		"timeout": {
+			err:   wrap(&net.OpError{Op: "dial", Net: "tcp", Err: os.ErrDeadlineExceeded}),
+			retry: true,
+		},
+		"temporary name resolution": {
+			err:   wrap(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Name: "awx.example.com", IsTemporary: true}}),
+			retry: true,
+		},
+		"unknown host": {
+			err:   wrap(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Name: "awx.example.com", IsNotFound: true}}),
+			retry: false,
+		},
+		"unknown authority": {
+			err:   wrap(x509.UnknownAuthorityError{}),
+			retry: false,
+		},
+		"wrong host name": {
+			err:   wrap(x509.HostnameError{Host: "awx.example.com", Certificate: new(x509.Certificate)}),
+			retry: false,
+		},
+		"context cancelled": {
+			err:   wrap(context.Canceled),
+			retry: false,
+		},
+		"context deadline": {
+			err:   wrap(context.DeadlineExceeded),
+			retry: false,
+		},
+	}
+	policy := newRetryPolicy(nil)
+	for name, test := range errors {
+		_, retry := policy.delay(http.MethodGet, 1, nil, test.err)
+		if retry != test.retry {
+			t.Errorf("Expected retry to be %v for %s, got %v", test.retry, name, retry)
+		}
+	}
+}
+
+func TestRetryAfterLimited(t *testing.T) {
+	policy := newRetryPolicy(&RetryPolicy{
+		MaxInterval: 5 * time.Second,
+	})
+	response := &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header: http.Header{
+			"Retry-After": []string{"3600"},
+		},
+	}
+	delay, retry := policy.delay(http.MethodGet, 1, response, nil)
+	if !retry || delay != 5*time.Second {
+		t.Errorf("Expected retry after five seconds, got %v and %s", retry, delay)
+	}
+}
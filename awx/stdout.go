@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the request used to retrieve the standard output of
+// jobs and updates.
+
+package awx
+
+import (
+	"context"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type StdoutFormat string
+
+const (
+	StdoutFormatText         StdoutFormat = "txt"
+	StdoutFormatTextDownload StdoutFormat = "txt_download"
+	StdoutFormatANSI         StdoutFormat = "ansi"
+	StdoutFormatANSIDownload StdoutFormat = "ansi_download"
+	StdoutFormatHTML         StdoutFormat = "html"
+	StdoutFormatJSON         StdoutFormat = "json"
+)
+
+// StdoutGetRequest retrieves the output of a job or update. By default the output is returned as
+// plain text inside a JSON document, which also contains the range of lines returned.
+//
+type StdoutGetRequest struct {
+	Request
+
+	format StdoutFormat
+	start  int
+	end    int
+}
+
+func newStdoutGetRequest(connection *Connection, path string) *StdoutGetRequest {
+	request := new(StdoutGetRequest)
+	request.resource = &Resource{
+		connection: connection,
+		path:       path,
+	}
+	request.format = StdoutFormatJSON
+	return request
+}
+
+// Format sets the format of the output. The Start, End and AbsoluteEnd methods of the response are
+// only meaningful when the format is JSON, the default.
+//
+func (r *StdoutGetRequest) Format(value StdoutFormat) *StdoutGetRequest {
+	r.format = value
+	return r
+}
+
+// Start sets the number of the first line of the output to return, starting with zero.
+//
+func (r *StdoutGetRequest) Start(value int) *StdoutGetRequest {
+	r.start = value
+	return r
+}
+
+// End sets the number of the line following the last line of the output to return. The default is
+// to return all the lines till the end of the output.
+//
+func (r *StdoutGetRequest) End(value int) *StdoutGetRequest {
+	r.end = value
+	return r
+}
+
+func (r *StdoutGetRequest) Send() (response *StdoutGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *StdoutGetRequest) SendContext(ctx context.Context) (response *StdoutGetResponse, err error) {
+	r.setParameter("format", r.format)
+	if r.start > 0 {
+		r.setParameter("start_line", r.start)
+	}
+	if r.end > 0 {
+		r.setParameter("end_line", r.end)
+	}
+
+	// All the formats other than JSON are returned as they are:
+	if r.format != StdoutFormatJSON {
+		accept := "text/plain"
+		if r.format == StdoutFormatHTML {
+			accept = "text/html"
+		}
+		var output []byte
+		output, err = r.getRaw(ctx, accept)
+		if err != nil {
+			return
+		}
+		response = new(StdoutGetResponse)
+		response.content = string(output)
+		return
+	}
+
+	output := new(data.StdoutGetResponse)
+	err = r.get(ctx, output)
+	if err != nil {
+		return
+	}
+	response = new(StdoutGetResponse)
+	response.content = output.Content
+	response.start = output.Range.Start
+	response.end = output.Range.End
+	response.absoluteEnd = output.Range.AbsoluteEnd
+	return
+}
+
+type StdoutGetResponse struct {
+	content     string
+	start       int
+	end         int
+	absoluteEnd int
+}
+
+// Content returns the text of the output, in the requested format.
+//
+func (r *StdoutGetResponse) Content() string {
+	return r.content
+}
+
+// Start returns the number of the first line of the output that has been returned.
+//
+func (r *StdoutGetResponse) Start() int {
+	return r.start
+}
+
+// End returns the number of the line following the last line of the output that has been
+// returned.
+//
+func (r *StdoutGetResponse) End() int {
+	return r.end
+}
+
+// AbsoluteEnd returns the total number of lines of the output available in the server.
+//
+func (r *StdoutGetResponse) AbsoluteEnd() int {
+	return r.absoluteEnd
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the retrieval of the output of jobs.
+
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStdoutText(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/jobs/3/stdout/" {
+			t.Errorf("Unexpected path '%s'", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("format") != "txt" || query.Get("start_line") != "2" || query.Get("end_line") != "4" {
+			t.Errorf("Unexpected query '%s'", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "TASK [ping]\nok: [localhost]\n")
+	}))
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	response, err := connection.Jobs().Id(3).Stdout().
+		Format(StdoutFormatText).
+		Start(2).
+		End(4).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "TASK [ping]\nok: [localhost]\n"
+	if response.Content() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, response.Content())
+	}
+}
+
+func TestStdoutJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("format") != "json" {
+			t.Errorf("Unexpected query '%s'", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"range": {"start": 0, "end": 2, "absolute_end": 10}, "content": "a\nb\n"}`)
+	}))
+	defer server.Close()
+	connection := newTestConnection(t, server)
+
+	response, err := connection.Jobs().Id(3).Stdout().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Content() != "a\nb\n" || response.End() != 2 || response.AbsoluteEnd() != 10 {
+		t.Errorf("Unexpected response content '%s', end %d and absolute end %d",
+			response.Content(), response.End(), response.AbsoluteEnd())
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the TLS settings of connections.
+
+package awx
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newClientCertificate generates a self signed client certificate and returns the PEM encoded
+// certificate and key.
+func newClientCertificate(t *testing.T) (cert, key []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	key = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return
+}
+
+// newMutualTLSServer creates a server that requires a client certificate and responds with the
+// common name of the certificate presented by the client.
+func newMutualTLSServer() *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": 1, "name": "%s"}`, r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+		MaxVersion: tls.VersionTLS12,
+	}
+	server.StartTLS()
+	return server
+}
+
+// serverCA returns the PEM encoded certificate of the server, so that it can be trusted.
+func serverCA(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func TestClientCertificate(t *testing.T) {
+	server := newMutualTLSServer()
+	defer server.Close()
+	cert, key := newClientCertificate(t)
+	connection, err := NewConnectionBuilder().
+		URL(server.URL+"/api").
+		Bearer("token").
+		CACertificates(serverCA(server)).
+		ClientCertificate(cert, key).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	response, err := connection.Projects().Id(1).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Result().Name() != "client" {
+		t.Errorf("Expected the server to receive the client certificate, got '%s'", response.Result().Name())
+	}
+}
+
+func TestClientCertificateFiles(t *testing.T) {
+	server := newMutualTLSServer()
+	defer server.Close()
+	cert, key := newClientCertificate(t)
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	if err := ioutil.WriteFile(certFile, cert, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		t.Fatal(err)
+	}
+	connection, err := NewConnectionBuilder().
+		URL(server.URL+"/api").
+		Bearer("token").
+		CACertificates(serverCA(server)).
+		ClientCertificateFiles(certFile, keyFile).
+		ServerName("example.com").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	_, err = connection.Projects().Id(1).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTLSSettingsRejected(t *testing.T) {
+	server := newMutualTLSServer()
+	defer server.Close()
+	cert, key := newClientCertificate(t)
+	builders := map[string]*ConnectionBuilder{
+		"no client certificate": NewConnectionBuilder(),
+		"wrong server name":     NewConnectionBuilder().ClientCertificate(cert, key).ServerName("awx.invalid"),
+		"minimum version":       NewConnectionBuilder().ClientCertificate(cert, key).MinTLSVersion(tls.VersionTLS13),
+	}
+	for name, builder := range builders {
+		connection, err := builder.
+			URL(server.URL + "/api").
+			Bearer("token").
+			CACertificates(serverCA(server)).
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = connection.Projects().Id(1).Get().Send()
+		if err == nil {
+			t.Errorf("Expected the request to fail with %s", name)
+		}
+		connection.Close()
+	}
+}
+
+func TestClientCertificateInvalid(t *testing.T) {
+	_, err := NewConnectionBuilder().
+		URL("https://awx.example.com/api").
+		Bearer("token").
+		ClientCertificate([]byte("junk"), []byte("junk")).
+		Build()
+	if err == nil {
+		t.Errorf("Expected an error for an invalid client certificate")
+	}
+}
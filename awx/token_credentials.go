@@ -0,0 +1,343 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the credentials providers that exchange a user name and
+// password for a token.
+
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+// tokenExpiryMargin is the time before the expiration of a token when a new one will be requested,
+// so that requests never use a token that is about to expire.
+//
+const tokenExpiryMargin = time.Minute
+
+// tokenKind indicates which of the two implementations of authentication tokens of AWX should be
+// used to obtain tokens.
+//
+type tokenKind int
+
+const (
+	// Use OAuth2 personal access tokens if the server supports them, and the authtoken endpoint
+	// otherwise.
+	tokenKindAuto tokenKind = iota
+
+	// Use the authtoken endpoint, supported by AWX < 1.0.5 and Ansible Tower < 3.3.
+	tokenKindAuthToken
+
+	// Use OAuth2 personal access tokens, supported since AWX 1.0.5 and Ansible Tower 3.3.
+	tokenKindPAT
+)
+
+// PasswordCredentials returns a provider that exchanges the given user name and password for an
+// OAuth2 personal access token if the server supports them, or for a token obtained from the
+// authtoken endpoint otherwise. This is what the connection uses when the Username and Password
+// methods of the builder are used. The token is requested again before it expires, and revoked
+// when the connection is closed. The provider can't be shared by multiple connections.
+//
+func PasswordCredentials(username, password string) CredentialsProvider {
+	return newTokenCredentials(username, password, tokenKindAuto)
+}
+
+// AuthTokenCredentials returns a provider that exchanges the given user name and password for a
+// token using the authtoken endpoint, supported by AWX < 1.0.5 and Ansible Tower < 3.3. The token
+// is requested again before it expires, and revoked when the connection is closed. The provider
+// can't be shared by multiple connections.
+//
+func AuthTokenCredentials(username, password string) CredentialsProvider {
+	return newTokenCredentials(username, password, tokenKindAuthToken)
+}
+
+// PATCredentials returns a provider that exchanges the given user name and password for an OAuth2
+// personal access token, supported since AWX 1.0.5 and Ansible Tower 3.3. The token is requested
+// again before it expires, and revoked when the connection is closed. The provider can't be shared
+// by multiple connections.
+//
+func PATCredentials(username, password string) CredentialsProvider {
+	return newTokenCredentials(username, password, tokenKindPAT)
+}
+
+type tokenCredentials struct {
+	username   string
+	password   string
+	kind       tokenKind
+	connection *Connection
+
+	// AWX had two implementations for authentication tokens
+	token  string // using the /authtoken endpoint, used in tower < 3.3
+	bearer string // an OAuth2 implementation, used since tower 3.3
+
+	// Expiration time of the token, zero if unknown:
+	expires time.Time
+
+	// Identifier of the personal access token, needed to revoke it:
+	bearerId int
+
+	// Indicates that the provider has been closed, so no new tokens should be requested:
+	closed bool
+
+	// The token lock protects the token, bearer, identifier and expiration time, and the auth
+	// lock makes sure that only one new token is requested at a time and protects the closed flag:
+	tokenLock sync.RWMutex
+	authLock  sync.Mutex
+}
+
+func newTokenCredentials(username, password string, kind tokenKind) *tokenCredentials {
+	return &tokenCredentials{
+		username: username,
+		password: password,
+		kind:     kind,
+	}
+}
+
+func (p *tokenCredentials) bind(connection *Connection) {
+	p.connection = connection
+}
+
+// Authorization returns the current token, requesting a new one if there is none or if it is
+// about to expire. Once the provider has been closed no new token is requested, as nothing would
+// revoke it, and an error is returned instead.
+//
+func (p *tokenCredentials) Authorization(ctx context.Context) (string, error) {
+	authorization := p.current()
+	if authorization != "" {
+		return authorization, nil
+	}
+	p.authLock.Lock()
+	defer p.authLock.Unlock()
+	authorization = p.current()
+	if authorization != "" {
+		return authorization, nil
+	}
+	if p.closed {
+		return "", fmt.Errorf("Can't request a token because the connection has been closed")
+	}
+	err := p.getToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// Don't check the expiration time of the new token, as it may be shorter than the margin:
+	p.tokenLock.RLock()
+	defer p.tokenLock.RUnlock()
+	return p.header(), nil
+}
+
+// current returns the value of the 'Authorization' header for the current token, or an empty
+// string if there is no token or it is about to expire.
+//
+func (p *tokenCredentials) current() string {
+	p.tokenLock.RLock()
+	defer p.tokenLock.RUnlock()
+	if !p.expires.IsZero() && !time.Now().Add(tokenExpiryMargin).Before(p.expires) {
+		return ""
+	}
+	return p.header()
+}
+
+// header returns the value of the 'Authorization' header for the current token, or an empty string
+// if there is no token. The caller must hold the token lock.
+//
+func (p *tokenCredentials) header() string {
+	if p.token != "" {
+		return "Token " + p.token
+	}
+	if p.bearer != "" {
+		return "Bearer " + p.bearer
+	}
+	return ""
+}
+
+// Invalidate discards the token, so that a new one will be requested, but only if it is still the
+// given one, as it may have been already replaced by another goroutine. The discarded token is
+// revoked, in case the server still has it.
+//
+func (p *tokenCredentials) Invalidate(authorization string) {
+	p.tokenLock.Lock()
+	if authorization != "Token "+p.token && authorization != "Bearer "+p.bearer {
+		p.tokenLock.Unlock()
+		return
+	}
+	token, bearer, bearerId := p.token, p.bearer, p.bearerId
+	p.clear()
+	p.tokenLock.Unlock()
+	p.revokeReplaced(context.Background(), token, bearer, bearerId)
+}
+
+// clear discards the token. The caller must hold the token lock.
+//
+func (p *tokenCredentials) clear() {
+	p.token = ""
+	p.bearer = ""
+	p.bearerId = 0
+	p.expires = time.Time{}
+}
+
+// replace stores a new token and revokes the one that it replaces, if any, so that tokens don't
+// remain in the server when they are refreshed.
+//
+func (p *tokenCredentials) replace(ctx context.Context, token, bearer string, bearerId int, expires time.Time) {
+	p.tokenLock.Lock()
+	oldToken, oldBearer, oldBearerId := p.token, p.bearer, p.bearerId
+	p.clear()
+	p.token = token
+	p.bearer = bearer
+	p.bearerId = bearerId
+	p.expires = expires
+	p.tokenLock.Unlock()
+
+	// The authtoken endpoint returns the same token again if it is still valid, and that one
+	// must not be revoked:
+	if (oldToken != "" && oldToken == token) || (oldBearerId != 0 && oldBearerId == bearerId) {
+		return
+	}
+	p.revokeReplaced(ctx, oldToken, oldBearer, oldBearerId)
+}
+
+// revokeReplaced revokes a token that is no longer used. Failures are only logged, as they don't
+// affect the token that replaces it.
+//
+func (p *tokenCredentials) revokeReplaced(ctx context.Context, token, bearer string, bearerId int) {
+	err := p.revoke(ctx, token, bearer, bearerId)
+	if err != nil {
+		glog.Warning(err)
+	}
+}
+
+// Close revokes the current token, so that it doesn't remain in the server. The tokens that it
+// replaced have already been revoked when they were replaced. It is safe to call this method
+// multiple times, only the first call will revoke the token. No new tokens are requested after
+// this.
+//
+func (p *tokenCredentials) Close() error {
+	p.authLock.Lock()
+	defer p.authLock.Unlock()
+	p.closed = true
+	err := p.revokeToken(context.Background())
+	p.tokenLock.Lock()
+	defer p.tokenLock.Unlock()
+	p.clear()
+	return err
+}
+
+// revokeToken deletes the current token from the server.
+//
+func (p *tokenCredentials) revokeToken(ctx context.Context) error {
+	p.tokenLock.RLock()
+	token := p.token
+	bearer := p.bearer
+	bearerId := p.bearerId
+	p.tokenLock.RUnlock()
+	return p.revoke(ctx, token, bearer, bearerId)
+}
+
+// revoke deletes the given token from the server. The request is sent using the token itself, as
+// that is the only way to identify the token to delete when using the authtoken endpoint. Tokens
+// that the server no longer accepts are ignored, as there is nothing left to revoke.
+//
+func (p *tokenCredentials) revoke(ctx context.Context, token, bearer string, bearerId int) error {
+	var path string
+	var authorization string
+	switch {
+	case token != "":
+		path = "authtoken"
+		authorization = "Token " + token
+	case bearer != "" && bearerId != 0:
+		path = fmt.Sprintf("tokens/%d", bearerId)
+		authorization = "Bearer " + bearer
+	default:
+		return nil
+	}
+	if glog.V(2) {
+		glog.Infof("Revoking token '%s'", path)
+	}
+	ctx = withAuthorization(ctx, authorization)
+	_, err := p.connection.rawSend(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil && !IsNotFound(err) && !IsUnauthorized(err) {
+		return fmt.Errorf("Can't revoke token: %w", err)
+	}
+	return nil
+}
+
+// getToken requests a new authentication token. The requests are sent using basic authentication.
+//
+func (p *tokenCredentials) getToken(ctx context.Context) (err error) {
+	ctx = withAuthorization(ctx, basicAuthorization(p.username, p.password))
+	kind := p.kind
+	if kind == tokenKindAuto {
+		kind = tokenKindAuthToken
+		if p.connection.oauth2Supported(ctx) {
+			kind = tokenKindPAT
+		}
+	}
+	if kind == tokenKindPAT {
+		err = p.getPATToken(ctx)
+	} else {
+		err = p.getAuthToken(ctx)
+	}
+	return
+}
+
+func (p *tokenCredentials) getAuthToken(ctx context.Context) error {
+	if glog.V(2) {
+		glog.Infoln("Requesting Authtoken")
+	}
+	var request data.AuthTokenPostRequest
+	var response data.AuthTokenPostResponse
+	request.Username = p.username
+	request.Password = p.password
+	err := p.connection.post(ctx, "authtoken", nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	if len(response.Token) == 0 {
+		return fmt.Errorf("Error obtaining auth token")
+	}
+	p.replace(ctx, response.Token, "", 0, response.Expires)
+	return nil
+}
+
+func (p *tokenCredentials) getPATToken(ctx context.Context) error {
+	if glog.V(2) {
+		glog.Infoln("Requesting OAuth2 PAT Token")
+	}
+	var request data.PATPostRequest
+	var response data.PATPostResponse
+	request.Description = "AWX Go Client"
+	request.Scope = "write"
+	err := p.connection.post(
+		ctx,
+		fmt.Sprintf("users/%s/personal_tokens", p.username),
+		nil,
+		&request,
+		&response,
+	)
+	if err != nil {
+		return err
+	}
+	p.replace(ctx, "", response.Token, response.Id, response.Expires)
+	return nil
+}
@@ -0,0 +1,346 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the tests for the renewal of authentication tokens.
+
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// tokenServer is a server that issues personal access tokens with the given lifetime, and accepts
+// only the last issued token, unless it has been revoked.
+type tokenServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	lifetime time.Duration
+	issued   int
+	current  string
+	rejected int
+	revoked  []string
+}
+
+func newTokenServer(lifetime time.Duration) *tokenServer {
+	s := &tokenServer{lifetime: lifetime}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *tokenServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/api/o/":
+		return
+	case r.URL.Path == "/api/v2/users/admin/personal_tokens/":
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.issued++
+		s.current = fmt.Sprintf("token%d", s.issued)
+		expires := time.Now().Add(s.lifetime).UTC().Format(time.RFC3339)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id": %d, "token": "%s", "expires": "%s"}`, s.issued, s.current, expires)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/tokens/"):
+		s.revoked = append(s.revoked, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		if r.Header.Get("Authorization") != "Bearer "+s.current {
+			s.rejected++
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"detail": "Authentication credentials were not provided."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	}
+}
+
+// revokedPaths returns the paths of the tokens that have been revoked with DELETE requests.
+func (s *tokenServer) revokedPaths() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.revoked...)
+}
+
+// revoke makes the server reject the current token.
+func (s *tokenServer) revoke() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.current = "revoked"
+}
+
+func TestTokenReplayOnUnauthorized(t *testing.T) {
+	server := newTokenServer(time.Hour)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Username("admin").
+		Password("password").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	_, err = connection.Projects().Id(1).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.revoke()
+	_, err = connection.Projects().Id(1).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.issued != 2 {
+		t.Errorf("Expected 2 tokens to be issued, got %d", server.issued)
+	}
+	if server.rejected != 1 {
+		t.Errorf("Expected 1 rejected request, got %d", server.rejected)
+	}
+	expected := []string{"/api/v2/tokens/1/"}
+	if !reflect.DeepEqual(server.revokedPaths(), expected) {
+		t.Errorf("Expected the rejected token to be revoked %v, got %v", expected, server.revokedPaths())
+	}
+	if connection.credentials.(*tokenCredentials).expires.IsZero() {
+		t.Errorf("Expected the expiration time of the token to be recorded")
+	}
+}
+
+func TestTokenRefreshBeforeExpiry(t *testing.T) {
+	// The tokens expire before the margin, so a new one should be requested for each request:
+	server := newTokenServer(tokenExpiryMargin / 2)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Username("admin").
+		Password("password").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err = connection.Projects().Id(1).Get().Send()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if server.issued != 3 {
+		t.Errorf("Expected 3 tokens to be issued, got %d", server.issued)
+	}
+	if server.rejected != 0 {
+		t.Errorf("Expected no rejected requests, got %d", server.rejected)
+	}
+	expected := []string{"/api/v2/tokens/1/", "/api/v2/tokens/2/"}
+	if !reflect.DeepEqual(server.revokedPaths(), expected) {
+		t.Errorf("Expected the replaced tokens to be revoked %v, got %v", expected, server.revokedPaths())
+	}
+}
+
+func TestTokenNoReplayWithoutCredentials(t *testing.T) {
+	server := newTokenServer(time.Hour)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Bearer("wrong").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	_, err = connection.Projects().Id(1).Get().Send()
+	if !IsUnauthorized(err) {
+		t.Errorf("Expected unauthorized error, got %v", err)
+	}
+	if server.issued != 0 || server.rejected != 1 {
+		t.Errorf("Expected no tokens issued and 1 rejected request, got %d and %d", server.issued, server.rejected)
+	}
+}
+
+func TestTokenRevokedOnClose(t *testing.T) {
+	server := newTokenServer(time.Hour)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Username("admin").
+		Password("password").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = connection.Projects().Id(1).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = connection.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = connection.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"/api/v2/tokens/1/"}
+	if !reflect.DeepEqual(server.revoked, expected) {
+		t.Errorf("Expected revoked tokens %v, got %v", expected, server.revoked)
+	}
+	bearer := connection.credentials.(*tokenCredentials).bearer
+	if bearer != "" {
+		t.Errorf("Expected bearer to be discarded, got '%s'", bearer)
+	}
+}
+
+func TestTokenRevokedOnCloseAfterRefresh(t *testing.T) {
+	// The tokens expire before the margin, so each request replaces the previous one:
+	server := newTokenServer(tokenExpiryMargin / 2)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Username("admin").
+		Password("password").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = connection.Projects().Id(1).Get().Send()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = connection.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected []string
+	for id := 1; id <= server.issued; id++ {
+		expected = append(expected, fmt.Sprintf("/api/v2/tokens/%d/", id))
+	}
+	if len(expected) != 2 || !reflect.DeepEqual(server.revokedPaths(), expected) {
+		t.Errorf("Expected all issued tokens to be revoked %v, got %v", expected, server.revokedPaths())
+	}
+}
+
+func TestTokenNotRequestedAfterClose(t *testing.T) {
+	server := newTokenServer(time.Hour)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Username("admin").
+		Password("password").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = connection.Projects().Id(1).Get().Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = connection.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A new token would never be revoked, so the request must fail instead of requesting it:
+	_, err = connection.Projects().Id(1).Get().Send()
+	if err == nil {
+		t.Errorf("Expected an error after closing the connection")
+	}
+	if server.issued != 1 {
+		t.Errorf("Expected only 1 token to be issued, got %d", server.issued)
+	}
+}
+
+func TestTokenNotRevokedWhenGiven(t *testing.T) {
+	server := newTokenServer(time.Hour)
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Bearer("given").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = connection.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(server.revoked) != 0 {
+		t.Errorf("Expected no revoked tokens, got %v", server.revoked)
+	}
+}
+
+func TestAuthTokenReissuedNotRevoked(t *testing.T) {
+	// Old servers return the same token again while it is valid, so it must not be revoked when
+	// it is refreshed:
+	var lock sync.Mutex
+	issued := 0
+	revoked := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v2/authtoken/" && r.Method == http.MethodPost:
+			issued++
+			expires := time.Now().Add(tokenExpiryMargin / 2).UTC().Format(time.RFC3339)
+			fmt.Fprintf(w, `{"token": "mytoken", "expires": "%s"}`, expires)
+		case r.URL.Path == "/api/v2/authtoken/" && r.Method == http.MethodDelete:
+			revoked++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			fmt.Fprint(w, `{"id": 1}`)
+		}
+	}))
+	defer server.Close()
+	connection, err := NewConnectionBuilder().
+		URL(server.URL + "/api").
+		Credentials(AuthTokenCredentials("admin", "password")).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err = connection.Projects().Id(1).Get().Send()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if issued != 2 || revoked != 0 {
+		t.Errorf("Expected 2 tokens issued and none revoked, got %d and %d", issued, revoked)
+	}
+}
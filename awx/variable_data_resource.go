@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2018 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resource that manages the variables of an
+// inventory, group or host, decoded as a map.
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/moolitayer/awx-client-go/awx/internal/data"
+)
+
+type VariableDataResource struct {
+	Resource
+}
+
+func NewVariableDataResource(connection *Connection, path string) *VariableDataResource {
+	resource := new(VariableDataResource)
+	resource.connection = connection
+	resource.path = path
+	return resource
+}
+
+func (r *VariableDataResource) Get() *VariableDataGetRequest {
+	request := new(VariableDataGetRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *VariableDataResource) Put() *VariableDataPutRequest {
+	request := new(VariableDataPutRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+func (r *VariableDataResource) Patch() *VariableDataPatchRequest {
+	request := new(VariableDataPatchRequest)
+	request.resource = &r.Resource
+	return request
+}
+
+type VariableDataGetRequest struct {
+	Request
+}
+
+func (r *VariableDataGetRequest) Send() (response *VariableDataGetResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *VariableDataGetRequest) SendContext(ctx context.Context) (response *VariableDataGetResponse, err error) {
+	output := make(data.VariableDataGetResponse)
+	err = r.get(ctx, &output)
+	if err != nil {
+		return
+	}
+	response = new(VariableDataGetResponse)
+	response.variables = output
+	return
+}
+
+type VariableDataGetResponse struct {
+	variables map[string]interface{}
+}
+
+func (r *VariableDataGetResponse) Variables() map[string]interface{} {
+	return r.variables
+}
+
+// VariableDataPutRequest replaces all the variables with the ones given.
+//
+type VariableDataPutRequest struct {
+	Request
+
+	variables map[string]interface{}
+}
+
+// Variables sets the complete map of variables.
+//
+func (r *VariableDataPutRequest) Variables(value map[string]interface{}) *VariableDataPutRequest {
+	r.variables = value
+	return r
+}
+
+// Variable adds a single variable.
+//
+func (r *VariableDataPutRequest) Variable(name string, value interface{}) *VariableDataPutRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *VariableDataPutRequest) Send() (response *VariableDataPutResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *VariableDataPutRequest) SendContext(ctx context.Context) (response *VariableDataPutResponse, err error) {
+	input := make(data.VariableDataPutRequest)
+	for name, value := range r.variables {
+		input[name] = value
+	}
+	output := make(data.VariableDataPutResponse)
+	err = r.put(ctx, input, &output)
+	if err != nil {
+		return
+	}
+	response = new(VariableDataPutResponse)
+	response.variables = output
+	return
+}
+
+type VariableDataPutResponse struct {
+	variables map[string]interface{}
+}
+
+func (r *VariableDataPutResponse) Variables() map[string]interface{} {
+	return r.variables
+}
+
+// VariableDataPatchRequest adds or replaces the given variables, leaving the rest untouched. The
+// server replaces the complete document even when the request uses the PATCH method, so this
+// retrieves the current variables, merges the given ones and then replaces the document with the
+// result. Changes made by other clients between these two steps are lost.
+//
+type VariableDataPatchRequest struct {
+	Request
+
+	variables map[string]interface{}
+}
+
+// Variables sets the map of variables to add or replace.
+//
+func (r *VariableDataPatchRequest) Variables(value map[string]interface{}) *VariableDataPatchRequest {
+	r.variables = value
+	return r
+}
+
+// Variable adds or replaces a single variable.
+//
+func (r *VariableDataPatchRequest) Variable(name string, value interface{}) *VariableDataPatchRequest {
+	if r.variables == nil {
+		r.variables = make(map[string]interface{})
+	}
+	r.variables[name] = value
+	return r
+}
+
+func (r *VariableDataPatchRequest) Send() (response *VariableDataPatchResponse, err error) {
+	return r.SendContext(context.Background())
+}
+
+func (r *VariableDataPatchRequest) SendContext(ctx context.Context) (response *VariableDataPatchResponse, err error) {
+	current := make(data.VariableDataGetResponse)
+	err = r.get(ctx, &current)
+	if err != nil {
+		return
+	}
+	input := make(data.VariableDataPutRequest)
+	for name, value := range current {
+		input[name] = value
+	}
+	for name, value := range r.variables {
+		input[name] = value
+	}
+	output := make(data.VariableDataPutResponse)
+	err = r.put(ctx, input, &output)
+	if err != nil {
+		return
+	}
+	response = new(VariableDataPatchResponse)
+	response.variables = output
+	return
+}
+
+type VariableDataPatchResponse struct {
+	variables map[string]interface{}
+}
+
+func (r *VariableDataPatchResponse) Variables() map[string]interface{} {
+	return r.variables
+}
+
+// encodeVariables converts a map of variables into the JSON text that the server expects in the
+// 'variables' field of inventories, groups and hosts. It returns nil if the map is nil, so that
+// the field isn't sent.
+//
+func encodeVariables(variables map[string]interface{}) (result *string, err error) {
+	if variables == nil {
+		return
+	}
+	bytes, err := json.Marshal(variables)
+	if err != nil {
+		return
+	}
+	text := string(bytes)
+	result = &text
+	return
+}
+
+// decodeVariables converts the text of the 'variables' field of inventories, groups and hosts into
+// a map. The server stores that text as it was given, so it may be YAML instead of JSON. In that
+// case the variables are ignored, instead of failing to return the rest of the object, and the
+// result is nil.
+//
+func decodeVariables(text string) map[string]interface{} {
+	if text == "" {
+		return nil
+	}
+	var variables map[string]interface{}
+	if json.Unmarshal([]byte(text), &variables) != nil {
+		return nil
+	}
+	return variables
+}